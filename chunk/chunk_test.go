@@ -12,7 +12,7 @@ func Example() {
 	txt := "Go is a open source programming language created at Google."
 
 	words := tokenize.TextToWords(txt)
-	tagger := tag.NewPerceptronTagger()
+	tagger := tag.MustNewPerceptronTagger()
 
 	fmt.Println(Chunk(tagger.Tag(words), TreebankNamedEntities))
 	// Output: [Go Google]
@@ -66,7 +66,7 @@ It was also reported on BBC Radio 4 and BBC Radio 5 Live.
 	}
 
 	words := tokenize.TextToWords(text)
-	tagger := tag.NewPerceptronTagger()
+	tagger := tag.MustNewPerceptronTagger()
 	tagged := tagger.Tag(words)
 
 	for i, chunk := range Chunk(tagged, TreebankNamedEntities) {
@@ -51,7 +51,10 @@ func main() {
 			}
 		}
 		if len(text) > 0 {
-			tagger := tag.NewPerceptronTagger()
+			tagger, terr := tag.NewPerceptronTagger()
+			if terr != nil {
+				return terr
+			}
 			tags := tagger.Tag(strings.Split(string(text), " "))
 			b, jerr := json.Marshal(tags)
 			if jerr != nil {
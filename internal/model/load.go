@@ -10,9 +10,19 @@ import (
 	"github.com/jdkato/prose/internal/util"
 )
 
-// GetAsset returns the named Asset.
-func GetAsset(name string) *gob.Decoder {
+// GetAsset returns a decoder for the named Asset. A missing Asset is reported
+// as util.ErrModelCorrupt.
+func GetAsset(name string) (*gob.Decoder, error) {
 	b, err := Asset("internal/model/" + name)
+	if err != nil {
+		return nil, util.NewError(util.ErrModelCorrupt, err)
+	}
+	return gob.NewDecoder(bytes.NewReader(b)), nil
+}
+
+// MustGetAsset is like GetAsset, but it panics if the Asset can't be found.
+func MustGetAsset(name string) *gob.Decoder {
+	dec, err := GetAsset(name)
 	util.CheckError(err)
-	return gob.NewDecoder(bytes.NewReader(b))
+	return dec
 }
@@ -4,9 +4,13 @@ Package util contains internals used across the other prose packages.
 package util
 
 import (
+	"context"
+	"errors"
 	"io/ioutil"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 )
 
 // ReadDataFile reads data from a file, panicking on any errors.
@@ -27,6 +31,89 @@ func CheckError(err error) {
 	}
 }
 
+// The kinds of errors returned by constructors that load a model or compile a
+// user-supplied pattern; they're re-exported by the packages that use them.
+var (
+	ErrModelCorrupt = errors.New("corrupt model")
+	ErrBadPattern   = errors.New("bad pattern")
+)
+
+// An Error is an error of a particular Kind (e.g., ErrModelCorrupt) along with
+// its underlying cause.
+type Error struct {
+	Kind error
+	Err  error
+}
+
+// NewError creates an error of the given kind, caused by err.
+func NewError(kind, err error) error {
+	return &Error{Kind: kind, Err: err}
+}
+
+func (e *Error) Error() string {
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error's underlying cause, which allows callers using Go
+// 1.13 or later to inspect it with errors.As.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the error's Kind, which allows callers using
+// Go 1.13 or later to test for it with errors.Is.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// IsKind determines if err is an Error of the given kind (or kind itself). It
+// works on any version of Go, unlike errors.Is.
+func IsKind(err, kind error) bool {
+	if e, ok := err.(*Error); ok {
+		return e.Kind == kind
+	}
+	return err == kind
+}
+
+// ParallelFor calls f(i), for each i in [0, n), using up to workers goroutines
+// (or one per CPU if workers < 1). If ctx is canceled, no further calls are
+// started and ctx.Err() is returned once the running ones finish.
+func ParallelFor(ctx context.Context, n, workers int, f func(i int)) error {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				f(i)
+			}
+		}()
+	}
+
+	var err error
+	for i := 0; i < n && err == nil; i++ {
+		if err = ctx.Err(); err == nil {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return err
+}
+
 // Min returns the minimum of `a` and `b`.
 func Min(a, b int) int {
 	if a < b {
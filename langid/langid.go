@@ -0,0 +1,215 @@
+/*
+Package langid implements functions for identifying the language of text.
+
+Languages are identified by comparing the character n-grams of a text to
+those of each language's Profile. The built-in Profiles cover the languages
+supported by tokenize.PragmaticSegmenter: "en" (English), "es" (Spanish),
+"fr" (French), "de" (German), "zh" (Chinese), and "ja" (Japanese).
+*/
+package langid
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jdkato/prose/tokenize"
+)
+
+// MaxN is the length of the longest n-grams in a Profile.
+const MaxN = 3
+
+// ProfileSize is the number of n-grams kept in a Profile.
+const ProfileSize = 500
+
+// A Guess is a language along with the confidence (between 0 and 1) that a
+// text is written in it.
+type Guess struct {
+	Lang       string
+	Confidence float64
+}
+
+// A Profile maps a language's most frequent character n-grams (of lengths 1
+// through MaxN) to the number of times they were seen.
+type Profile map[string]int
+
+// NewProfile creates a Profile from a sample of text written in a single
+// language.
+//
+// Each word (i.e., run of letters) in text is converted to lowercase and
+// padded with a space on each side before its n-grams are counted; only the
+// ProfileSize most frequent n-grams are kept.
+func NewProfile(text string) Profile {
+	counts := ngrams(text)
+
+	ranked := byCount{counts: counts}
+	for gram := range counts {
+		ranked.grams = append(ranked.grams, gram)
+	}
+	sort.Sort(ranked)
+
+	p := make(Profile)
+	for i := 0; i < len(ranked.grams) && i < ProfileSize; i++ {
+		p[ranked.grams[i]] = counts[ranked.grams[i]]
+	}
+	return p
+}
+
+// Identifier guesses the language of text.
+type Identifier struct {
+	// Threshold is the minimum Confidence that NewSegmenter requires to use
+	// a language-specific PragmaticSegmenter.
+	Threshold float64
+
+	profiles map[string]Profile
+	totals   map[string]int  // the total count of each profile's n-grams
+	vocab    map[string]bool // all n-grams known to any profile
+}
+
+// NewIdentifier creates a new Identifier with the built-in language Profiles.
+func NewIdentifier() *Identifier {
+	id := Identifier{
+		Threshold: 0.5,
+		profiles:  make(map[string]Profile),
+		totals:    make(map[string]int),
+		vocab:     make(map[string]bool)}
+	for lang, p := range builtinProfiles {
+		id.AddProfile(lang, p)
+	}
+	return &id
+}
+
+// AddProfile adds (or replaces) the Profile for the given language.
+func (id *Identifier) AddProfile(lang string, p Profile) {
+	id.profiles[lang] = p
+	id.totals[lang] = 0
+	for gram, count := range p {
+		id.totals[lang] += count
+		id.vocab[gram] = true
+	}
+}
+
+// Languages returns the languages known to the Identifier.
+func (id Identifier) Languages() []string {
+	langs := make([]string, 0, len(id.profiles))
+	for lang := range id.profiles {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// Identify returns a Guess for each of the Identifier's languages, from most
+// to least likely. If text doesn't contain any n-grams in the Identifier's
+// Profiles, no guesses are returned.
+//
+// The likelihood of each language is estimated by a naive Bayes classifier
+// over text's n-grams, so its Confidence quickly approaches 1 as text gets
+// longer.
+func (id Identifier) Identify(text string) []Guess {
+	counts := ngrams(text)
+
+	found := false
+	for gram := range counts {
+		if id.vocab[gram] {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	guesses := []Guess{}
+	for lang, p := range id.profiles {
+		denom := math.Log(float64(id.totals[lang] + len(id.vocab)))
+		score := 0.0
+		for gram, count := range counts {
+			if id.vocab[gram] {
+				score += float64(count) * (math.Log(float64(p[gram]+1)) - denom)
+			}
+		}
+		guesses = append(guesses, Guess{Lang: lang, Confidence: score})
+	}
+
+	// Convert the log-likelihoods into probabilities.
+	max := math.Inf(-1)
+	for _, g := range guesses {
+		max = math.Max(max, g.Confidence)
+	}
+	total := 0.0
+	for i := range guesses {
+		guesses[i].Confidence = math.Exp(guesses[i].Confidence - max)
+		total += guesses[i].Confidence
+	}
+	for i := range guesses {
+		guesses[i].Confidence /= total
+	}
+
+	sort.Sort(byConfidence(guesses))
+	return guesses
+}
+
+// NewSegmenter creates a sentence tokenizer suited to text: a
+// PragmaticSegmenter for its most likely language, if that language is
+// supported and its Confidence is at least id.Threshold, or a
+// PunktSentenceTokenizer otherwise.
+func (id Identifier) NewSegmenter(text string) (tokenize.SpanTokenizer, error) {
+	if guesses := id.Identify(text); len(guesses) > 0 &&
+		guesses[0].Confidence >= id.Threshold {
+		if seg, err := tokenize.NewPragmaticSegmenter(guesses[0].Lang); err == nil {
+			return seg, nil
+		}
+	}
+	return tokenize.NewPunktSentenceTokenizer()
+}
+
+// ngrams counts the n-grams of each word in text.
+func ngrams(text string) map[string]int {
+	counts := make(map[string]int)
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r)
+	})
+	for _, word := range words {
+		runes := []rune(" " + strings.ToLower(word) + " ")
+		for n := 1; n <= MaxN; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				if gram := string(runes[i : i+n]); gram != " " {
+					counts[gram]++
+				}
+			}
+		}
+	}
+	return counts
+}
+
+// byConfidence sorts Guesses from the most to least confident, breaking ties
+// by language.
+type byConfidence []Guess
+
+func (b byConfidence) Len() int      { return len(b) }
+func (b byConfidence) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byConfidence) Less(i, j int) bool {
+	if b[i].Confidence != b[j].Confidence {
+		return b[i].Confidence > b[j].Confidence
+	}
+	return b[i].Lang < b[j].Lang
+}
+
+// byCount sorts n-grams from the most to least frequent, breaking ties
+// alphabetically.
+type byCount struct {
+	grams  []string
+	counts map[string]int
+}
+
+func (b byCount) Len() int      { return len(b.grams) }
+func (b byCount) Swap(i, j int) { b.grams[i], b.grams[j] = b.grams[j], b.grams[i] }
+func (b byCount) Less(i, j int) bool {
+	ci, cj := b.counts[b.grams[i]], b.counts[b.grams[j]]
+	if ci != cj {
+		return ci > cj
+	}
+	return b.grams[i] < b.grams[j]
+}
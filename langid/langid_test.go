@@ -0,0 +1,104 @@
+package langid
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/jdkato/prose/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+var testdata = filepath.Join("..", "testdata")
+
+type goldenRule struct {
+	Name   string
+	Input  string
+	Output []string
+}
+
+func ExampleIdentifier_Identify() {
+	id := NewIdentifier()
+	guesses := id.Identify("Wir haben heute keine Zeit, aber morgen vielleicht.")
+	fmt.Println(guesses[0].Lang)
+	// Output: de
+}
+
+func TestBuiltinProfiles(t *testing.T) {
+	for lang, profile := range builtinProfiles {
+		sample := util.ReadDataFile(filepath.Join(testdata, "langid", lang+".txt"))
+		assert.Equal(t, NewProfile(string(sample)), profile, lang)
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	id := NewIdentifier()
+	for _, lang := range id.Languages() {
+		tests := make([]goldenRule, 0)
+		cases := util.ReadDataFile(filepath.Join(testdata, "golden_rules_"+lang+".json"))
+		util.CheckError(json.Unmarshal(cases, &tests))
+
+		correct := 0
+		for _, test := range tests {
+			guesses := id.Identify(test.Input)
+			if assert.Len(t, guesses, 6) && guesses[0].Lang == lang {
+				correct++
+			}
+		}
+		accuracy := float64(correct) / float64(len(tests))
+		assert.True(t, accuracy >= 0.9, "%s: %.2f", lang, accuracy)
+	}
+}
+
+func TestIdentifyConfidence(t *testing.T) {
+	id := NewIdentifier()
+	guesses := id.Identify("La casa es muy bonita y tiene un jardín grande.")
+
+	total := 0.0
+	for i, g := range guesses {
+		if i > 0 {
+			assert.True(t, g.Confidence <= guesses[i-1].Confidence)
+		}
+		total += g.Confidence
+	}
+	assert.Equal(t, "es", guesses[0].Lang)
+	assert.InDelta(t, 1.0, total, 1e-9)
+
+	assert.Nil(t, id.Identify("1234 5678"))
+	assert.Nil(t, id.Identify(""))
+}
+
+func TestAddProfile(t *testing.T) {
+	id := NewIdentifier()
+	id.AddProfile("it", NewProfile(
+		"Il gatto dorme sul divano mentre la pioggia cade sulla città. "+
+			"Questa sera andiamo a cena con gli amici della scuola, che non "+
+			"vediamo da molti anni. Gli scienziati si chiedono da tempo perché "+
+			"alcuni uccelli percorrono migliaia di chilometri ogni anno, mentre "+
+			"altri restano nello stesso posto per tutta la vita. Imparare una "+
+			"nuova lingua non è mai facile, soprattutto per gli adulti che "+
+			"hanno poco tempo libero."))
+	assert.Contains(t, id.Languages(), "it")
+
+	guesses := id.Identify("Il gatto della città dorme con gli amici.")
+	assert.Equal(t, "it", guesses[0].Lang)
+}
+
+func TestNewSegmenter(t *testing.T) {
+	id := NewIdentifier()
+
+	seg, err := id.NewSegmenter("Es gibt jedoch einige Vorsichtsmaßnahmen, die Du ergreifen kannst.")
+	assert.Nil(t, err)
+	assert.IsType(t, &tokenize.PragmaticSegmenter{}, seg)
+
+	seg, err = id.NewSegmenter("1, 2, 3.")
+	assert.Nil(t, err)
+	assert.IsType(t, &tokenize.PunktSentenceTokenizer{}, seg)
+
+	id.Threshold = 1.1
+	seg, err = id.NewSegmenter("This is English. It has two sentences.")
+	assert.Nil(t, err)
+	assert.IsType(t, &tokenize.PunktSentenceTokenizer{}, seg)
+}
@@ -0,0 +1,415 @@
+// Code generated by scripts/langid.py. DO NOT EDIT.
+
+package langid
+
+// builtinProfiles are built from the samples in testdata/langid.
+var builtinProfiles = map[string]Profile{
+	"de": {
+		"e": 421, "n": 279, "i": 163, "r": 160, "n ": 138, "a": 136, "en": 130,
+		"s": 130, "t": 127, "d": 113, "en ": 102, "h": 100, "e ": 86, "u": 85,
+		"l": 81, "er": 74, "c": 66, "g": 65, " d": 61, "ch": 59, "te": 58, "m": 54,
+		"r ": 50, "o": 48, "ie": 46, " s": 43, "de": 42, " a": 41, "ei": 41,
+		"f": 41, "b": 39, "w": 37, "in": 36, "k": 34, "nd": 34, "di": 33, "er ": 33,
+		"z": 32, "ne": 31, " w": 30, "die": 30, "an": 29, "ge": 29, "ie ": 29,
+		" di": 28, " e": 27, "un": 27, "s ": 26, "st": 26, "be": 24, "re": 24,
+		" de": 22, " z": 22, "d ": 22, "he": 22, "se": 22, "t ": 22, "v": 22,
+		" m": 21, "au": 21, "ein": 21, "es": 21, "ten": 21, "ic": 20, "ich": 20,
+		"zu": 20, " v": 19, "le": 19, " f": 18, " u": 18, " un": 18, " zu": 18,
+		"che": 18, "h ": 18, "nd ": 18, "te ": 18, "ü": 18, " i": 17, "ch ": 17,
+		"me": 17, " n": 16, "den": 16, "der": 16, "el": 16, "g ": 16, "m ": 16,
+		"sc": 16, "sch": 16, "hr": 15, "und": 15, " l": 14, "ine": 14, "it": 14,
+		"ng": 14, "ter": 14, " au": 13, " b": 13, " ei": 13, " k": 13, "al": 13,
+		"nde": 13, "ra": 13, "we": 13, "ac": 12, "ach": 12, "ar": 12, "ben": 12,
+		"in ": 12, "p": 12, "u ": 12, "zu ": 12, " g": 11, " st": 11, " we": 11,
+		"ig": 11, "lt": 11, "lte": 11, "nen": 11, "nt": 11, "or": 11, "si": 11,
+		"ta": 11, "ä": 11, " h": 10, "auf": 10, "ern": 10, "ke": 10, "ni": 10,
+		"on": 10, "ren": 10, "rn": 10, "rs": 10, "ss": 10, "ste": 10, "tt": 10,
+		"uf": 10, "us": 10, "vo": 10, " me": 9, " se": 9, " t": 9, " vo": 9,
+		"end": 9, "es ": 9, "ha": 9, "is": 9, "j": 9, "la": 9, "ma": 9, "na": 9,
+		"rt": 9, "wi": 9, " an": 8, "am": 8, "eh": 8, "he ": 8, "hen": 8, "ht": 8,
+		"ken": 8, "li": 8, "ng ": 8, "nn": 8, "sic": 8, " al": 7, " da": 7,
+		" in": 7, " j": 7, " la": 7, " r": 7, " sc": 7, " si": 7, " wi": 7, "ab": 7,
+		"ah": 7, "am ": 7, "and": 7, "ang": 7, "as": 7, "cht": 7, "ck": 7, "da": 7,
+		"eg": 7, "ers": 7, "eu": 7, "ge ": 7, "gen": 7, "hre": 7, "it ": 7,
+		"lan": 7, "ll": 7, "ne ": 7, "ns": 7, "nte": 7, "ol": 7, "sen": 7, "tte": 7,
+		"ve": 7, "wa": 7, "wo": 7, "ze": 7, " am": 6, " er": 6, " fr": 6, " ge": 6,
+		" le": 6, " na": 6, " wa": 6, "an ": 6, "at": 6, "aus": 6, "das": 6,
+		"ed": 6, "em": 6, "f ": 6, "fe": 6, "fr": 6, "gel": 6, "gt": 6, "hr ": 6,
+		"l ": 6, "men": 6, "mi": 6, "on ": 6, "ri": 6, "ro": 6, "sei": 6, "ver": 6,
+		"war": 6, "ß": 6, "ße": 6, "ö": 6, " be": 5, " ha": 5, " ma": 5, " mi": 5,
+		" ne": 5, " ta": 5, " ve": 5, " wo": 5, "abe": 5, "ag": 5, "ahr": 5,
+		"de ": 5, "eb": 5, "eit": 5, "eni": 5, "ere": 5, "ert": 5, "fü": 5, "ga": 5,
+		"hi": 5, "iel": 5, "ir": 5, "je": 5, "kl": 5, "kt": 5, "lic": 5, "man": 5,
+		"mei": 5, "mit": 5, "nac": 5, "nig": 5, "nk": 5, "rn ": 5, "rte": 5,
+		"rü": 5, "so": 5, "sp": 5, "ss ": 5, "sta": 5, "tr": 5, "uf ": 5, "unt": 5,
+		"wen": 5, " ab": 4, " fü": 4, " je": 4, " ni": 4, " p": 4, " so": 4,
+		" sp": 4, "als": 4, "ass": 4, "att": 4, "ber": 4, "cke": 4, "ebe": 4,
+		"ens": 4, "et": 4, "eue": 4, "fen": 4, "fo": 4, "hn": 4, "ier": 4, "ig ": 4,
+		"il": 4, "ir ": 4, "ist": 4, "ja": 4, "jah": 4, "jed": 4, "kle": 4,
+		"len": 4, "ler": 4, "ls": 4, "ls ": 4, "neu": 4, "nke": 4, "nn ": 4,
+		"oc": 4, "oll": 4, "om": 4, "ort": 4, "pr": 4, "ran": 4, "rd": 4, "rde": 4,
+		"rei": 4, "rk": 4, "rne": 4, "ru": 4, "sa": 4, "ser": 4, "sse": 4, "ti": 4,
+		"tl": 4, "ue": 4, "ung": 4, "ur": 4, "use": 4, "von": 4, "vor": 4, "wer": 4,
+		"wir": 4, "ßen": 4, "üc": 4, " dr": 3, " fo": 3, " ih": 3, " im": 3,
+		" ja": 3, " ki": 3, " kr": 3, " re": 3, " te": 3, " vi": 3, " ü": 3,
+		" üb": 3, "age": 3, "al ": 3, "alt": 3, "aß": 3, "aße": 3, "bei": 3,
+		"bes": 3, "br": 3, "cha": 3, "dr": 3, "ec": 3, "ede": 3, "ege": 3, "ehr": 3,
+		"ei ": 3, "eis": 3, "eln": 3, "elt": 3, "erd": 3, "ese": 3, "est": 3,
+		"ete": 3, "geb": 3, "gt ": 3, "gte": 3, "hat": 3, "hm": 3, "ht ": 3,
+		"hte": 3, "i ": 3, "ib": 3, "igt": 3, "ih": 3, "ihr": 3, "im": 3, "im ": 3,
+		"inn": 3, "itt": 3, "k ": 3, "ki": 3, "kr": 3, "kte": 3, "ld": 3, "ld ": 3,
+		"le ": 3, "lei": 3, "llt": 3, "ln": 3, "ln ": 3, "ner": 3, "nf": 3,
+		"nge": 3, "nie": 3, "nne": 3, "nst": 3, "ors": 3, "pl": 3, "rac": 3,
+		"rb": 3, "rbe": 3, "re ": 3, "reg": 3, "rit": 3, "rkt": 3, "rsc": 3,
+		"rst": 3, "spr": 3, "st ": 3, "str": 3, "stu": 3, "tag": 3, "tau": 3,
+		"tli": 3, "tra": 3, "tu": 3, "tz": 3, "tze": 3, "uc": 3, "uch": 3, "ue ": 3,
+		"vi": 3, "vie": 3, "win": 3, "wor": 3, "zei": 3, "zur": 3, "üb": 3,
+		"übe": 3, "ück": 3, "üg": 3, " ar": 2, " bi": 2, " br": 2, " bä": 2,
+		" en": 2, " es": 2, " fe": 2, " gl": 2, " gr": 2, " hö": 2, " ic": 2,
+		" is": 2, " kl": 2, " ko": 2, " o": 2, " pl": 2, " vö": 2, "ad": 2, "af": 2,
+		"ag ": 2, "ank": 2, "ann": 2, "ant": 2, "ar ": 2, "arb": 2, "are": 2,
+		"ark": 2, "as ": 2, "auc": 2, "ba": 2, "bi": 2, "bl": 2, "bä": 2, "chi": 2,
+		"cho": 2, "chr": 2, "chs": 2, "ckl": 2, "dem": 2, "des": 2, "dri": 2,
+		"ds": 2, "du": 2, "ech": 2, "ehm": 2, "eib": 2, "eic": 2, "eig": 2,
+		"eil": 2, "el ": 2, "eld": 2, "ele": 2, "em ": 2, "ema": 2, "ene": 2,
+		"ent": 2, "erf": 2, "erh": 2, "ess": 2, "ew": 2, "ewi": 2, "fa": 2,
+	},
+	"en": {
+		"e": 264, "t": 194, "a": 165, "o": 141, "s": 138, "n": 137, "r": 133,
+		"h": 128, "i": 124, "d": 95, "e ": 92, "l": 88, " t": 86, "th": 78,
+		"s ": 69, " th": 67, "w": 63, "he": 61, "d ": 52, "the": 51, " w": 48,
+		"c": 47, "u": 46, "he ": 44, "g": 42, "t ": 42, "f": 41, "y": 41, "m": 40,
+		"in": 39, " a": 38, "p": 36, " s": 34, "n ": 34, "er": 32, "re": 32,
+		"y ": 31, "an": 30, " o": 28, "ha": 28, "b": 27, "v": 26, "ng": 25,
+		"r ": 25, "ar": 24, "at": 24, "nd": 23, "ve": 23, "g ": 22, "ng ": 22,
+		"on": 22, " b": 21, " f": 21, " i": 21, "ea": 21, "en": 21, "ing": 21,
+		"it": 21, " m": 20, "es": 20, " r": 18, "ed": 18, "k": 18, "st": 18,
+		" h": 17, "nd ": 17, "ou": 17, " an": 16, "ed ": 16, "o ": 16, "tha": 16,
+		"we": 16, " l": 15, "at ": 15, "le": 15, "ll": 15, "ne": 15, "or": 15,
+		"to": 15, " c": 14, "al": 14, "and": 14, "ch": 14, "hat": 14, "ts": 14,
+		" d": 13, " e": 13, " n": 13, " p": 13, "h ": 13, "ro": 13, "te": 13,
+		"ts ": 13, " we": 12, "ad": 12, "er ": 12, "ho": 12, "nt": 12, "of": 12,
+		"wa": 12, " of": 11, " to": 11, " wa": 11, " wh": 11, "a ": 11, "f ": 11,
+		"wh": 11, " a ": 10, " on": 10, "as": 10, "es ": 10, "fo": 10, "il": 10,
+		"l ": 10, "ld": 10, "ma": 10, "of ": 10, "on ": 10, "ra": 10, "ri": 10,
+		"to ": 10, "ver": 10, " fo": 9, " in": 9, " ne": 9, " re": 9, "an ": 9,
+		"ev": 9, "hi": 9, "mo": 9, "re ": 9, "rs": 9, "ti": 9, " be": 8, " ha": 8,
+		" ma": 8, " mo": 8, "all": 8, "as ": 8, "ay": 8, "be": 8, "ds": 8, "ds ": 8,
+		"ere": 8, "ic": 8, "in ": 8, "ir": 8, "is": 8, "la": 8, "ld ": 8, "li": 8,
+		"me": 8, "oo": 8, "or ": 8, "rea": 8, "ul": 8, "w ": 8, " li": 7, " st": 7,
+		" wi": 7, "ac": 7, "ai": 7, "ce": 7, "ch ": 7, "de": 7, "di": 7, "ear": 7,
+		"ee": 7, "eve": 7, "fi": 7, "ke": 7, "le ": 7, "no": 7, "ow": 7, "pe": 7,
+		"rs ": 7, "ry": 7, "sa": 7, "sh": 7, "si": 7, "wer": 7, "wi": 7, "wo": 7,
+		" it": 6, " sh": 6, " wo": 6, "ad ": 6, "ay ": 6, "el": 6, "en ": 6,
+		"ent": 6, "for": 6, "ge": 6, "id": 6, "ll ": 6, "lo": 6, "nt ": 6, "om": 6,
+		"os": 6, "oul": 6, "ov": 6, "ove": 6, "pl": 6, "pr": 6, "se": 6, "st ": 6,
+		"ua": 6, "uld": 6, "ve ": 6, "was": 6, " ev": 5, "are": 5, "ci": 5, "co": 5,
+		"cr": 5, "dr": 5, "dy": 5, "ec": 5, "ery": 5, "et": 5, "ex": 5, "her": 5,
+		"hou": 5, "ie": 5, "ith": 5, "k ": 5, "ls": 5, "ls ": 5, "nc": 5, "ns": 5,
+		"od": 5, "ol": 5, "pa": 5, "res": 5, "rk": 5, "rt": 5, "ry ": 5, "sp": 5,
+		"ss": 5, "ter": 5, "th ": 5, "tu": 5, "un": 5, "ur": 5, "us": 5, "ut": 5,
+		"wit": 5, "x": 5, " bu": 4, " cr": 4, " fi": 4, " fr": 4, " g": 4, " no": 4,
+		" pr": 4, " ra": 4, " sa": 4, " si": 4, " y": 4, "ark": 4, "av": 4, "bo": 4,
+		"bu": 4, "ce ": 4, "ct": 4, "da": 4, "din": 4, "ead": 4, "end": 4, "et ": 4,
+		"ew": 4, "ew ": 4, "ey": 4, "ey ": 4, "fr": 4, "had": 4, "is ": 4, "it ": 4,
+		"its": 4, "iv": 4, "ive": 4, "ki": 4, "kin": 4, "ly": 4, "ly ": 4, "me ": 4,
+		"nce": 4, "new": 4, "ni": 4, "nin": 4, "one": 4, "op": 4, "ow ": 4,
+		"pro": 4, "rin": 4, "rn": 4, "tho": 4, "tr": 4, "tt": 4, "ud": 4, "we ": 4,
+		"whi": 4, "wou": 4, "yi": 4, "yin": 4, " bi": 3, " ch": 3, " co": 3,
+		" da": 3, " dr": 3, " ea": 3, " he": 3, " ho": 3, " is": 3, " k": 3,
+		" la": 3, " le": 3, " pa": 3, " pl": 3, " ro": 3, " sm": 3, " sp": 3,
+		" tr": 3, " ye": 3, "ach": 3, "adi": 3, "ain": 3, "ak": 3, "ap": 3,
+		"ars": 3, "ath": 3, "ave": 3, "bi": 3, "br": 3, "che": 3, "com": 3,
+		"de ": 3, "do": 3, "du": 3, "dy ": 3, "eac": 3, "ef": 3, "ei": 3, "eir": 3,
+		"ep": 3, "ers": 3, "ess": 3, "ext": 3, "ga": 3, "gu": 3, "han": 3, "har": 3,
+		"hav": 3, "hei": 3, "hic": 3, "hil": 3, "ho ": 3, "ich": 3, "ide": 3,
+		"ig": 3, "ill": 3, "im": 3, "ins": 3, "io": 3, "ir ": 3, "ird": 3, "ist": 3,
+		"itt": 3, "ket": 3, "lan": 3, "lit": 3, "m ": 3, "men": 3, "mon": 3,
+		"mp": 3, "nds": 3, "nex": 3, "nti": 3, "ob": 3, "oun": 3, "owe": 3, "p ": 3,
+		"par": 3, "pen": 3, "pla": 3, "po": 3, "q": 3, "qu": 3, "rai": 3, "rd": 3,
+		"red": 3, "ren": 3, "rk ": 3, "sha": 3, "sm": 3, "spe": 3, "ss ": 3,
+		"ste": 3, "stu": 3, "su": 3, "ta": 3, "te ": 3, "tic": 3, "tl": 3, "tle": 3,
+		"tor": 3, "ttl": 3, "tud": 3, "udy": 3, "ut ": 3, "ves": 3, "who": 3,
+		"xt": 3, "xt ": 3, "ye": 3, "yea": 3, " al": 2, " ar": 2, " bo": 2,
+		" br": 2, " ca": 2, " de": 2, " di": 2, " do": 2, " ex": 2, " fa": 2,
+		" ga": 2, " go": 2, " hi": 2, " i ": 2, " lo": 2, " mi": 2, " my": 2,
+		" ol": 2, " ov": 2, " q": 2, " qu": 2, " sc": 2, " u": 2, "ag": 2, "age": 2,
+		"aid": 2, "ail": 2, "ake": 2, "al ": 2, "alk": 2, "als": 2, "am": 2,
+		"ang": 2, "any": 2, "app": 2, "ar ": 2, "arg": 2, "art": 2, "ate": 2,
+		"be ": 2, "bir": 2, "bod": 2, "bs": 2, "bs ": 2, "but": 2, "ca": 2,
+		"ced": 2, "chi": 2, "cia": 2, "ck": 2, "cro": 2, "day": 2, "dre": 2,
+		"dro": 2, "dyi": 2, "eas": 2, "eat": 2, "eco": 2, "eg": 2, "ell": 2,
+		"enc": 2, "eni": 2, "eo": 2, "ern": 2, "est": 2, "fa": 2, "fal": 2, "fe": 2,
+		"ff": 2, "fir": 2, "fou": 2, "fre": 2, "ft": 2, "ge ": 2, "ges": 2, "go": 2,
+		"gr": 2, "gua": 2, "hel": 2, "hen": 2, "hey": 2, "hos": 2, "hu": 2, "i ": 2,
+	},
+	"es": {
+		"e": 288, "a": 281, "s": 199, "o": 180, "n": 153, "r": 146, "l": 133,
+		"i": 125, "s ": 120, "u": 107, "d": 106, "c": 90, "t": 83, "a ": 82,
+		"e ": 79, "m": 73, "o ": 61, "p": 61, " l": 59, "es": 54, "os": 54,
+		" d": 50, "n ": 50, "os ": 50, "de": 47, "an": 46, " e": 45, "ue": 42,
+		" p": 41, "er": 41, "as": 40, "la": 40, "en": 39, " de": 38, "b": 38,
+		" a": 37, "as ": 36, "q": 34, "qu": 34, " c": 33, "que": 31, " m": 30,
+		"ar": 30, "de ": 30, " la": 29, " q": 29, " qu": 29, "ra": 29, " s": 28,
+		"ue ": 28, "es ": 27, "r ": 27, "nt": 25, "g": 24, "re": 24, "v": 23,
+		"l ": 22, "an ": 21, " t": 20, "el": 20, "le": 20, "í": 20, "ab": 19,
+		"ad": 19, "ba": 19, "co": 19, "di": 19, "ie": 19, "lo": 19, "na": 19,
+		"ta": 19, "ca": 18, "la ": 18, "on": 18, "un": 18, "do": 17, "h": 17,
+		"po": 17, "ro": 17, "se": 17, " lo": 16, "ci": 16, "las": 16, "te": 16,
+		"y": 16, "el ": 15, "mi": 15, "st": 15, "to": 15, " el": 14, " y": 14,
+		"aba": 14, "da": 14, "in": 14, "los": 14, "ma": 14, "pr": 14, "ti": 14,
+		"ía": 14, " n": 13, "al": 13, "y ": 13, " es": 12, " h": 12, " se": 12,
+		" y ": 12, "ent": 12, "est": 12, "f": 12, "ll": 12, "or": 12, "á": 12,
+		" a ": 11, " co": 11, " mi": 11, " pr": 11, "am": 11, "do ": 11, "en ": 11,
+		"io": 11, "me": 11, "no": 11, "pa": 11, " u": 10, " un": 10, "ar ": 10,
+		"cu": 10, "em": 10, "ha": 10, "il": 10, "im": 10, "mp": 10, "tr": 10,
+		" ca": 9, " i": 9, " pa": 9, " po": 9, " r": 9, "ado": 9, "ant": 9, "ce": 9,
+		"er ": 9, "era": 9, "ga": 9, "ia": 9, "j": 9, "mo": 9, "ne": 9, "nte": 9,
+		"pe": 9, "ra ": 9, "res": 9, "si": 9, "so": 9, "ve": 9, " en": 8, "ban": 8,
+		"con": 8, "ec": 8, "ic": 8, "li": 8, "mos": 8, "nd": 8, "ran": 8, "ri": 8,
+		"su": 8, "ía ": 8, " al": 7, " di": 7, " ha": 7, " su": 7, "ac": 7,
+		"amo": 7, "ana": 7, "des": 7, "emp": 7, "ero": 7, "id": 7, "ien": 7,
+		"les": 7, "nc": 7, "nu": 7, "om": 7, "ro ": 7, "rt": 7, "sc": 7, "se ": 7,
+		"sta": 7, "un ": 7, "ñ": 7, "ó": 7, " f": 6, " ll": 6, " nu": 6, " o": 6,
+		" pe": 6, " re": 6, "ba ": 6, "br": 6, "eg": 6, "ev": 6, "ier": 6, "ig": 6,
+		"is": 6, "lle": 6, "mu": 6, "na ": 6, "nas": 6, "nto": 6, "oc": 6, "od": 6,
+		"on ": 6, "or ": 6, "por": 6, "ras": 6, "rí": 6, "sa": 6, "tes": 6, "ud": 6,
+		"ur": 6, "vo": 6, "z": 6, "ño": 6, " em": 5, " g": 5, " in": 5, " me": 5,
+		" mu": 5, " ti": 5, " to": 5, "al ": 5, "ch": 5, "cio": 5, "da ": 5,
+		"dio": 5, "dos": 5, "esc": 5, "fi": 5, "ib": 5, "io ": 5, "mer": 5, "má": 5,
+		"no ": 5, "ns": 5, "nta": 5, "ol": 5, "par": 5, "pre": 5, "rc": 5, "re ": 5,
+		"sp": 5, "ta ": 5, "tie": 5, "to ": 5, "tu": 5, "uc": 5, "ui": 5, "ura": 5,
+		"vi": 5, "é": 5, " an": 4, " b": 4, " ci": 4, " cu": 4, " j": 4, " ju": 4,
+		" ma": 4, " má": 4, " no": 4, " si": 4, " so": 4, " tr": 4, " v": 4,
+		"añ": 4, "bl": 4, "ca ": 4, "cad": 4, "car": 4, "co ": 4, "cr": 4, "du": 4,
+		"ed": 4, "end": 4, "ene": 4, "gar": 4, "go": 4, "gr": 4, "gu": 4, "hab": 4,
+		"ho": 4, "ida": 4, "ime": 4, "ir": 4, "ju": 4, "lu": 4, "ma ": 4, "mil": 4,
+		"mpo": 4, "más": 4, "nci": 4, "nos": 4, "nue": 4, "nv": 4, "ot": 4,
+		"per": 4, "pl": 4, "po ": 4, "ren": 4, "rim": 4, "rs": 4, "rá": 4, "ría": 4,
+		"scu": 4, "tan": 4, "te ": 4, "tod": 4, "tos": 4, "tra": 4, "ua": 4,
+		"uev": 4, "ug": 4, "una": 4, "ás": 4, "ás ": 4, "ían": 4, "ños": 4,
+		" añ": 3, " cr": 3, " er": 3, " le": 3, " lu": 3, " pl": 3, " te": 3,
+		"adi": 3, "and": 3, "ara": 3, "art": 3, "av": 3, "ave": 3, "año": 3,
+		"bam": 3, "be": 3, "bi": 3, "bre": 3, "cen": 3, "cha": 3, "com": 3,
+		"cos": 3, "ct": 3, "cua": 3, "dad": 3, "del": 3, "ega": 3, "ej": 3,
+		"erc": 3, "ert": 3, "eva": 3, "evo": 3, "ez": 3, "fe": 3, "fic": 3,
+		"gra": 3, "hac": 3, "ia ": 3, "ici": 3, "iem": 3, "ile": 3, "ill": 3,
+		"ima": 3, "ina": 3, "inv": 3, "it": 3, "jo": 3, "leg": 3, "lo ": 3,
+		"man": 3, "mar": 3, "mie": 3, "mig": 3, "nad": 3, "nde": 3, "ndo": 3,
+		"nes": 3, "nve": 3, "ob": 3, "oco": 3, "oma": 3, "ona": 3, "ons": 3,
+		"pan": 3, "pla": 3, "poc": 3, "pri": 3, "pro": 3, "pu": 3, "pue": 3,
+		"rca": 3, "ron": 3, "ros": 3, "rr": 3, "rá ": 3, "sab": 3, "so ": 3,
+		"spo": 3, "sti": 3, "stu": 3, "su ": 3, "sus": 3, "tab": 3, "tar": 3,
+		"ten": 3, "tro": 3, "tud": 3, "u ": 3, "uch": 3, "uda": 3, "udi": 3,
+		"uga": 3, "ul": 3, "unt": 3, "us": 3, "us ": 3, "va": 3, "ver": 3, "ves": 3,
+		"vo ": 3, "x": 3, "za": 3, "á ": 3, "áb": 3, "ába": 3, "ó ": 3, " ac": 2,
+		" am": 2, " av": 2, " du": 2, " fe": 2, " fu": 2, " go": 2, " gr": 2,
+		" he": 2, " ho": 2, " id": 2, " li": 2, " na": 2, " ol": 2, " ot": 2,
+		" pu": 2, " ta": 2, " ve": 2, " vi": 2, " yo": 2, "abl": 2, "abí": 2,
+		"ací": 2, "ad ": 2, "ada": 2, "ale": 2, "all": 2, "ami": 2, "anc": 2,
+		"arc": 2, "ard": 2, "arr": 2, "ará": 2, "ble": 2, "bí": 2, "bía": 2,
+		"cam": 2, "cer": 2, "ces": 2, "cho": 2, "cia": 2, "cie": 2, "cil": 2,
+		"ció": 2, "cue": 2, "cí": 2, "cía": 2, "d ": 2, "dan": 2, "der": 2,
+		"dia": 2, "die": 2, "dif": 2, "din": 2, "dis": 2, "dur": 2, "dí": 2,
+		"día": 2, "ece": 2, "edi": 2, "egu": 2, "ela": 2, "ema": 2, "enc": 2,
+		"eno": 2, "ens": 2, "ení": 2, "ep": 2,
+	},
+	"fr": {
+		"e": 370, "s": 223, "a": 184, "n": 183, "i": 177, "t": 177, "u": 166,
+		"r": 160, "s ": 151, "l": 134, "e ": 121, "o": 117, "d": 93, "es": 85,
+		"es ": 76, "p": 74, "t ": 73, " d": 70, "c": 68, "m": 65, " l": 61, "é": 61,
+		"le": 57, "nt": 55, "de": 51, "en": 51, " a": 48, " p": 46, " de": 45,
+		"ai": 45, "re": 43, "v": 42, "q": 41, "on": 40, "qu": 40, "nt ": 38,
+		" le": 36, "ent": 35, "ou": 35, " c": 33, "ie": 30, "g": 29, " e": 28,
+		" q": 28, " qu": 28, " s": 27, "an": 27, "is": 27, "les": 26, "ur": 26,
+		"de ": 25, "ue": 25, "er": 24, "eu": 23, "h": 22, "ns": 22, "r ": 22,
+		" m": 21, " n": 21, "it": 21, "le ": 20, "n ": 20, "que": 20, "se": 20,
+		"te": 20, "ue ": 20, "a ": 19, "ar": 19, "b": 18, "in": 18, "la": 18,
+		"tr": 18, "ui": 18, " é": 17, "au": 17, "em": 17, "i ": 17, "ne": 17,
+		"no": 17, "ra": 17, "ta": 17, "et": 16, "f": 16, "pr": 16, "re ": 16,
+		"ti": 16, " no": 15, " t": 15, "ce": 15, "des": 15, "li": 15, "nou": 15,
+		"ns ": 15, "oi": 15, "u ": 15, "ve": 15, "aie": 14, "d ": 14, "ien": 14,
+		"it ": 14, "ll": 14, "ons": 14, "us": 14, "ut": 14, "é ": 14, "ch": 13,
+		"co": 13, "et ": 13, "is ": 13, "ma": 13, "me": 13, "rs": 13, "uv": 13,
+		"è": 13, " au": 12, " d ": 12, " et": 12, " la": 12, " r": 12, " ét": 12,
+		"ait": 12, "av": 12, "il": 12, "pl": 12, "qui": 12, "ri": 12, "ro": 12,
+		"us ": 12, "va": 12, "ét": 12, " pr": 11, "ant": 11, "eur": 11, "io": 11,
+		"ne ": 11, "pe": 11, "po": 11, "res": 11, "ui ": 11, "un": 11, "ux": 11,
+		"ux ": 11, "x": 11, "x ": 11, " co": 10, " pe": 10, " u": 10, " un": 10,
+		"al": 10, "l ": 10, "la ": 10, "mi": 10, "mp": 10, "nd": 10, "ouv": 10,
+		"pa": 10, "sa": 10, "ur ": 10, " av": 9, " b": 9, " f": 9, " pa": 9,
+		" pl": 9, "er ": 9, "ir": 9, "lle": 9, "lo": 9, "nn": 9, "rs ": 9, "st": 9,
+		"tai": 9, "tre": 9, "uve": 9, " ce": 8, " en": 8, " tr": 8, "ain": 8,
+		"ais": 8, "at": 8, "di": 8, "ge": 8, "ion": 8, "lu": 8, "our": 8, "out": 8,
+		"par": 8, "rai": 8, "rt": 8, "ts": 8, "ts ": 8, "vi": 8, "éta": 8, " g": 7,
+		" i": 7, " ma": 7, " se": 7, " à": 7, " à ": 7, "au ": 7, "aux": 7,
+		"ava": 7, "con": 7, "el": 7, "emp": 7, "im": 7, "mo": 7, "nc": 7, "né": 7,
+		"ois": 7, "or": 7, "ous": 7, "plu": 7, "ré": 7, "so": 7, "ss": 7, "su": 7,
+		"vai": 7, "à": 7, "à ": 7, "éc": 7, " ch": 6, " h": 6, " mo": 6, " o": 6,
+		" po": 6, " v": 6, "ag": 6, "cha": 6, "end": 6, "ers": 6, "est": 6, "ha": 6,
+		"he": 6, "ill": 6, "ise": 6, "me ": 6, "ng": 6, "nts": 6, "om": 6, "on ": 6,
+		"ont": 6, "se ": 6, "tes": 6, "to": 6, "tu": 6, "té": 6, "ua": 6, "une": 6,
+		"ure": 6, "ée": 6, " a ": 5, " an": 5, " l ": 5, " mi": 5, " re": 5,
+		" so": 5, " su": 5, "ann": 5, "bl": 5, "ci": 5, "da": 5, "ec": 5, "ell": 5,
+		"ep": 5, "ie ": 5, "ier": 5, "ine": 5, "ire": 5, "lan": 5, "leu": 5,
+		"men": 5, "pro": 5, "rc": 5, "rem": 5, "rta": 5, "son": 5, "tem": 5,
+		"ute": 5, "ès": 5, "ès ": 5, "és": 5, "ê": 5, "ô": 5, " ba": 4, " dé": 4,
+		" es": 4, " j": 4, " lo": 4, " to": 4, " vi": 4, "ac": 4, "am": 4, "arc": 4,
+		"ard": 4, "ba": 4, "ble": 4, "c ": 4, "ce ": 4, "che": 4, "cr": 4, "dan": 4,
+		"dr": 4, "du": 4, "dé": 4, "ea": 4, "eau": 4, "ei": 4, "era": 4, "eux": 4,
+		"fa": 4, "gen": 4, "gé": 4, "il ": 4, "iv": 4, "iè": 4, "j": 4, "lli": 4,
+		"lon": 4, "lus": 4, "mai": 4, "mar": 4, "moi": 4, "mps": 4, "ndr": 4,
+		"nes": 4, "nti": 4, "oc": 4, "och": 4, "onn": 4, "peu": 4, "pon": 4,
+		"pou": 4, "pre": 4, "ps": 4, "ps ": 4, "rd": 4, "ren": 4, "rr": 4, "rè": 4,
+		"rès": 4, "sai": 4, "ses": 4, "tou": 4, "té ": 4, "un ": 4, "urs": 4,
+		"van": 4, "vel": 4, "ver": 4, "vie": 4, "vr": 4, "èr": 4, "ère": 4,
+		"ées": 4, "én": 4, "ér": 4, "és ": 4, "ôt": 4, " am": 3, " ap": 3, " ar": 3,
+		" di": 3, " fa": 3, " im": 3, " li": 3, " n ": 3, " on": 3, " sa": 3,
+		" éc": 3, "ale": 3, "ang": 3, "ans": 3, "ap": 3, "aq": 3, "aqu": 3,
+		"art": 3, "as": 3, "ati": 3, "ave": 3, "cen": 3, "cl": 3, "cou": 3, "ct": 3,
+		"dep": 3, "dre": 3, "eil": 3, "ema": 3, "eme": 3, "emi": 3, "epu": 3,
+		"eu ": 3, "ev": 3, "fo": 3, "gu": 3, "gue": 3, "haq": 3, "heu": 3, "hé": 3,
+		"ib": 3, "if": 3, "imp": 3, "in ": 3, "ins": 3, "iq": 3, "iqu": 3, "iss": 3,
+		"len": 3, "lie": 3, "lis": 3, "mb": 3, "mie": 3, "mil": 3, "mm": 3,
+		"mme": 3, "mon": 3, "mpl": 3, "mé": 3, "na": 3, "nce": 3, "ngu": 3,
+		"nné": 3, "nse": 3, "nv": 3, "née": 3, "oi ": 3, "onc": 3, "ong": 3,
+		"ors": 3, "ort": 3, "pen": 3, "pri": 3, "pu": 3, "pui": 3, "qua": 3,
+		"ral": 3, "rch": 3, "ris": 3, "rri": 3, "ru": 3, "rée": 3, "si": 3, "sp": 3,
+		"ssa": 3, "sur": 3, "tag": 3, "te ": 3, "tio": 3, "tir": 3, "tit": 3,
+		"tra": 3, "tro": 3, "tt": 3, "tte": 3, "tud": 3, "ud": 3, "ues": 3,
+		"uis": 3, "ul": 3, "ut ": 3, "vo": 3, "vra": 3, "y": 3, "éné": 3, "étu": 3,
+		"êt": 3, "êtr": 3, " al": 2, " as": 2, " bo": 2, " cl": 2, " cr": 2,
+		" cô": 2, " da": 2, " du": 2, " em": 2, " fe": 2, " fo": 2, " ge": 2,
+		" go": 2, " gé": 2, " he": 2, " hi": 2, " il": 2, " in": 2, " jo": 2,
+		" mê": 2, " oi": 2, " ro": 2, " ru": 2, " ré": 2, " s ": 2, " si": 2,
+		" ê": 2, " êt": 2, "ad": 2, "age": 2, "ali": 2, "all": 2, "an ": 2,
+		"and": 2,
+	},
+	"ja": {
+		"に": 37, "の": 37, "た": 36, "い": 33, "は": 31, "る": 29, "し": 24, "と": 24,
+		"て": 23, "な": 23, "を": 21, "り": 18, "が": 17, "っ": 16, "も": 15, "た ": 14,
+		"き": 13, "で": 13, "か": 12, "こ": 12, "く": 11, "てい": 11, "れ": 10, "だ": 8,
+		"ち": 8, "って": 8, "いた": 7, "こと": 7, "さ": 7, "す": 7, "する": 7, "そ": 7, "は ": 7,
+		"よ": 7, "ら": 7, "人": 7, " そ": 6, "いる": 6, "う": 6, "お": 6, "した": 6, "して": 6,
+		"たち": 6, "った": 6, "め": 6, "ると": 6, "大": 6, "間": 6, " その": 5, "あ": 5,
+		"いた ": 5, "け": 5, "その": 5, "たちは": 5, "ちは": 5, "べ": 5, "ま": 5, "るこ": 5,
+		"ること": 5, "一": 5, "者": 5, "きた": 4, "してい": 4, "ってい": 4, "ていた": 4, "ている": 4,
+		"ど": 4, "ない": 4, "なる": 4, "や": 4, "り ": 4, "りを": 4, "ん": 4, "パ": 4, "ン": 4,
+		"会": 4, "出": 4, "場": 4, "大き": 4, "年": 4, "私": 4, "鳥": 4, " 私": 3, "しい": 3,
+		"ず": 3, "たと": 3, "たり": 3, "だ ": 3, "だっ": 3, "だった": 3, "った ": 3, "にあ": 3,
+		"にな": 3, "になる": 3, "によ": 3, "ば": 3, "もの": 3, "より": 3, "りに": 3, "るの": 3,
+		"れて": 3, "ト": 3, "パン": 3, "ー": 3, "何": 3, "学": 3, "小": 3, "少": 3, "建": 3,
+		"新": 3, "毎": 3, "物": 3, "生": 3, "誰": 3, " こ": 2, " 一": 2, " 妹": 2, " 新": 2,
+		" 新し": 2, " 最": 2, " 毎": 2, " 私た": 2, "々": 2, "々は": 2, "々は ": 2, "ある": 2,
+		"いな": 2, "いる ": 2, "い間": 2, "え": 2, "かっ": 2, "かった": 2, "が ": 2, "がい": 2,
+		"がいる": 2, "きた ": 2, "きな": 2, "ぎ": 2, "く ": 2, "ける": 2, "けるこ": 2, "こう": 2,
+		"ことに": 2, "ことを": 2, "さな": 2, "され": 2, "し ": 2, "した ": 2, "したと": 2, "じ": 2,
+		"するこ": 2, "すると": 2, "せ": 2, "たが": 2, "たが ": 2, "ったが": 2, "てお": 2, "てき": 2,
+		"てきた": 2, "ての": 2, "で ": 2, "とに": 2, "とを": 2, "と発": 2, "と発表": 2, "と述": 2,
+		"と述べ": 2, "なお": 2, "なか": 2, "なかっ": 2, "なると": 2, "にある": 2, "にと": 2, "にも": 2,
+		"に出": 2, "のか": 2, "のだ": 2, "のは": 2, "はな": 2, "は誰": 2, "は誰も": 2, "ほ": 2,
+		"む": 2, "めた": 2, "めて": 2, "も ": 2, "ものだ": 2, "よりも": 2, "られ": 2, "られて": 2,
+		"りも": 2, "りも ": 2, "る ": 2, "るのか": 2, "るよ": 2, "る鳥": 2, "る鳥が": 2, "れば": 2,
+		"を聞": 2, "ベ": 2, "上": 2, "中": 2, "人々": 2, "人々は": 2, "初": 2, "勉": 2, "勉強": 2,
+		"千": 2, "向": 2, "四": 2, "場に": 2, "夜": 2, "大きな": 2, "妹": 2, "小さ": 2,
+		"小さな": 2, "少し": 2, "市": 2, "市場": 2, "市場に": 2, "強": 2, "当": 2, "後": 2,
+		"思": 2, "数": 2, "新し": 2, "新しい": 2, "方": 2, "日": 2, "明": 2, "時": 2, "時間": 2,
+		"書": 2, "最": 2, "渡": 2, "物の": 2, "発": 2, "発表": 2, "発表し": 2, "研": 2, "研究": 2,
+		"私た": 2, "私たち": 2, "私は": 2, "究": 2, "突": 2, "突き": 2, "続": 2, "者た": 2,
+		"者たち": 2, "者に": 2, "聞": 2, "落": 2, "表": 2, "表し": 2, "言": 2, "話": 2, "語": 2,
+		"語を": 2, "誰も": 2, "資": 2, "述": 2, "述べ": 2, "追": 2, "通": 2, "進": 2, "道": 2,
+		"金": 2, "金は": 2, "長": 2, "長い": 2, "長い間": 2, "鳥が": 2, "鳥がい": 2, " い": 1,
+		" いち": 1, " お": 1, " お金": 1, " この": 1, " これ": 1, " し": 1, " しか": 1,
+		" それ": 1, " な": 1, " なぜ": 1, " や": 1, " やさ": 1, " ラ": 1, " ラジ": 1, " 一人": 1,
+		" 一生": 1, " 丘": 1, " 丘の": 1, " 中": 1, " 中の": 1, " 今": 1, " 今後": 1, " 仕": 1,
+		" 仕事": 1, " 冬": 1, " 冬の": 1, " 利": 1, " 利益": 1, " 地": 1, " 地方": 1, " 夜": 1,
+		" 夜に": 1, " 大": 1, " 大き": 1, " 妹と": 1, " 妹は": 1, " 子": 1, " 子ど": 1, " 専": 1,
+		" 専門": 1, " 川": 1, " 川を": 1, " 広": 1, " 広場": 1, " 担": 1, " 担当": 1, " 政": 1,
+		" 政府": 1, " 早": 1, " 早く": 1, " 最初": 1, " 最高": 1, " 来": 1, " 来年": 1, " 株": 1,
+		" 株価": 1, " 棚": 1, " 棚に": 1, " 毎日": 1, " 毎朝": 1, " 焼": 1, " 焼き": 1, " 研": 1,
+		" 研究": 1, " 私は": 1, " 科": 1, " 科学": 1, " 結": 1, " 結果": 1, " 自": 1, " 自由": 1,
+		" 親": 1, " 親た": 1, " 週": 1, " 週に": 1, " 進": 1, " 進歩": 1, " 鉄": 1, " 鉄道": 1,
+		"あき": 1, "あきら": 1, "あま": 1, "あまり": 1, "あり": 1, "あり ": 1, "ある小": 1, "ある教": 1,
+		"い ": 1, "いう": 1, "いう ": 1, "いか": 1, "いかけ": 1, "いく": 1, "いく電": 1, "いこ": 1,
+		"いこと": 1, "いたり": 1, "いた人": 1, "いち": 1, "いちば": 1, "いて": 1, "いて ": 1,
+		"いない": 1, "いなか": 1, "いるこ": 1, "いると": 1, "いるの": 1, "いる一": 1, "いを": 1,
+		"いを生": 1, "い図": 1, "い図書": 1, "い大": 1, "い大人": 1, "い建": 1, "い建物": 1, "い本": 1,
+		"い本が": 1, "い物": 1, "い物語": 1, "い研": 1, "い研究": 1, "い言": 1, "い言語": 1, "い間な": 1,
+		"い間不": 1, "い雲": 1, "い雲が": 1, "う ": 1, "うと": 1, "うとし": 1, "うな": 1, "うな午": 1,
+		"うに": 1, "うにあ": 1, "うべ": 1, "うべき": 1, "う途": 1, "う途中": 1, "えさ": 1, "えさを": 1,
+		"えは": 1, "えは天": 1, "おさ": 1, "おさら": 1, "おざ": 1, "おざり": 1, "おし": 1, "おしゃ": 1,
+		"おり": 1, "おり ": 1, "お茶": 1, "お茶を": 1, "お金": 1, "お金は": 1, "か ": 1, "かう": 1,
+		"かう途": 1, "かぎ": 1, "かぎ ": 1, "かく": 1, "かく ": 1, "かけ": 1, "かけ ": 1, "かし": 1,
+		"かし批": 1, "かで": 1, "かで暖": 1, "かも": 1, "かもし": 1, "から": 1, "から出": 1, "かり": 1,
+		"かりを": 1, "がぎ": 1, "がぎっ": 1, "がで": 1, "ができ": 1, "がよ": 1, "がより": 1, "がポ": 1,
+		"がポケ": 1, "が三": 1, "が三四": 1, "が外": 1, "が外に": 1, "が大": 1, "が大き": 1, "が市": 1,
+		"が市場": 1, "が生": 1, "が生ま": 1, "が落": 1, "が落ち": 1, "が遅": 1, "が遅く": 1, "が都": 1,
+		"が都会": 1, "が集": 1, "が集ま": 1, "き ": 1, "きい": 1, "きいこ": 1, "きく": 1, "きく下": 1,
+		"きたて": 1, "きたと": 1, "きだ": 1, "きだと": 1, "きな市": 1, "きな違": 1, "きら": 1,
+		"きらめ": 1, "き当": 1, "き当た": 1, "き止": 1, "き止め": 1, "ぎ ": 1, "ぎっ": 1, "ぎっし": 1,
+		"くこ": 1, "くこと": 1, "くず": 1, "くずで": 1, "くな": 1,
+	},
+	"zh": {
+		"的": 29, "一": 16, "人": 9, "在": 9, "们": 8, "上": 7, "个": 7, "来": 7, "里": 7,
+		"天": 6, "我": 6, "每": 6, "说": 6, "这": 6, "下": 5, "于": 5, "公": 5, "到": 5,
+		"学": 5, "年": 5, "新": 5, "是": 5, "而": 5, " 我": 4, "些": 4, "和": 4, "大": 4,
+		"小": 4, "开": 4, "很": 4, "数": 4, "都": 4, "面": 4, "鸟": 4, " 一": 3, " 我们": 3,
+		" 而": 3, " 这": 3, "不": 3, "习": 3, "书": 3, "了": 3, "他": 3, "以": 3, "位": 3,
+		"其": 3, "包": 3, "大的": 3, "家": 3, "就": 3, "我们": 3, "新的": 3, "时": 3, "明": 3,
+		"最": 3, "有": 3, "每天": 3, "用": 3, "第": 3, "表": 3, "路": 3, "过": 3, "道": 3,
+		"那": 3, "里的": 3, "门": 3, "阅": 3, "面包": 3, " 他": 2, " 他们": 2, " 但": 2,
+		" 那": 2, "一个": 2, "业": 2, "为": 2, "么": 2, "习一": 2, "二": 2, "些鸟": 2,
+		"些鸟类": 2, "什": 2, "什么": 2, "从": 2, "他们": 2, "但": 2, "公司": 2, "内": 2, "决": 2,
+		"决于": 2, "几": 2, "几个": 2, "助": 2, "医": 2, "千": 2, "取": 2, "取决": 2, "取决于": 2,
+		"可": 2, "司": 2, "听": 2, "员": 2, "地": 2, "场": 2, "坐": 2, "坐在": 2, "多": 2,
+		"好": 2, "如": 2, "妹": 2, "子": 2, "季": 2, "学习": 2, "官": 2, "对": 2, "将": 2,
+		"已": 2, "市": 2, "帮": 2, "帮助": 2, "广": 2, "建": 2, "待": 2, "待在": 2, "得": 2,
+		"想": 2, "收": 2, "数千": 2, "是一": 2, "期": 2, "来很": 2, "没": 2, "的面": 2,
+		"的面包": 2, "直": 2, "看": 2, "研": 2, "研究": 2, "示": 2, "示 ": 2, "究": 2, "第一": 2,
+		"类": 2, "老": 2, "能": 2, "获": 2, "表示": 2, "表示 ": 2, "要": 2, "言": 2, "该": 2,
+		"语": 2, "语言": 2, "说 ": 2, "说取": 2, "说取决": 2, "路 ": 2, "踪": 2, "边": 2,
+		"进": 2, "里 ": 2, "钱": 2, "长": 2, "项": 2, "馆": 2, "鸟类": 2, " 一位": 1,
+		" 一旦": 1, " 一项": 1, " 上": 1, " 上班": 1, " 不": 1, " 不如": 1, " 专": 1, " 专家": 1,
+		" 为": 1, " 为什": 1, " 书": 1, " 书架": 1, " 但他": 1, " 但过": 1, " 公": 1, " 公司": 1,
+		" 其": 1, " 其利": 1, " 即": 1, " 即使": 1, " 发": 1, " 发现": 1, " 她": 1, " 她在": 1,
+		" 学": 1, " 学习": 1, " 孩": 1, " 孩子": 1, " 官": 1, " 官员": 1, " 对": 1, " 对于": 1,
+		" 导": 1, " 导致": 1, " 将": 1, " 将在": 1, " 并": 1, " 并帮": 1, " 情": 1, " 情况": 1,
+		" 我和": 1, " 才": 1, " 才有": 1, " 批": 1, " 批评": 1, " 收": 1, " 收听": 1, " 政": 1,
+		" 政府": 1, " 旁": 1, " 旁边": 1, " 最": 1, " 最重": 1, " 桥": 1, " 桥梁": 1, " 每": 1,
+		" 每天": 1, " 然": 1, " 然而": 1, " 直": 1, " 直到": 1, " 看": 1, " 看着": 1, " 研": 1,
+		" 研究": 1, " 科": 1, " 科学": 1, " 答": 1, " 答案": 1, " 而不": 1, " 而另": 1,
+		" 而我": 1, " 该": 1, " 该公": 1, " 还": 1, " 还能": 1, " 这一": 1, " 这笔": 1,
+		" 这项": 1, " 那座": 1, " 那是": 1, " 都": 1, " 都能": 1, " 长": 1, " 长期": 1, " 阅": 1,
+		" 阅读": 1, " 馆": 1, " 馆内": 1, "一个地": 1, "一个让": 1, "一些": 1, "一些鸟": 1, "一位": 1,
+		"一位老": 1, "一家": 1, "一家每": 1, "一旦": 1, "一旦新": 1, "一栋": 1, "一栋没": 1, "一次": 1,
+		"一次学": 1, "一滴": 1, "一滴雨": 1, "一点": 1, "一点 ": 1, "一生": 1, "一生都": 1, "一直": 1,
+		"一直想": 1, "一结": 1, "一结果": 1, "一部": 1, "一部小": 1, "一门": 1, "一门新": 1, "一项": 1,
+		"一项新": 1, "三": 1, "三个": 1, "三个季": 1, "上 ": 1, "上市": 1, "上市 ": 1, "上摆": 1,
+		"上摆满": 1, "上班": 1, "上班路": 1, "上聊": 1, "上聊天": 1, "上聚": 1, "上聚集": 1, "上驶": 1,
+		"上驶过": 1, "下 ": 1, "下午": 1, "下午 ": 1, "下去": 1, "下去 ": 1, "下跌": 1, "下跌 ": 1,
+		"下降": 1, "下降 ": 1, "不如": 1, "不如说": 1, "不容": 1, "不容易": 1, "不是": 1, "不是每": 1,
+		"与": 1, "与其": 1, "与其说": 1, "专": 1, "专家": 1, "专家建": 1, "业岗": 1, "业岗位": 1,
+		"业进": 1, "业进入": 1, "个人": 1, "个人都": 1, "个地": 1, "个地方": 1, "个季": 1, "个季度": 1,
+		"个小": 1, "个小时": 1, "个就": 1, "个就业": 1, "个月": 1, "个月里": 1, "个让": 1, "个让每": 1,
+		"为 ": 1, "为什": 1, "为什么": 1, "久": 1, "久了": 1, "久了 ": 1, "么有": 1, "么有些": 1,
+		"么钱": 1, "么钱 ": 1, "乌": 1, "乌云": 1, "乌云 ": 1, "也": 1, "也是": 1, "也是收": 1,
+		"习一点": 1, "习一门": 1, "习好": 1, "习好几": 1, "书架": 1, "书架上": 1, "书籍": 1, "书籍 ": 1,
+		"书馆": 1, "书馆位": 1, "了 ": 1, "了多": 1, "了多年": 1, "了数": 1, "了数百": 1, "事": 1,
+		"事 ": 1, "二十": 1, "二十多": 1, "二宣": 1, "二宣布": 1, "于冬": 1, "于冬季": 1, "于天": 1,
+		"于天气": 1, "于学": 1, "于学校": 1, "于空": 1, "于空闲": 1, "于街": 1, "于街道": 1, "云": 1,
+		"云 ": 1, "五": 1, "五年": 1, "五年内": 1, "些最": 1, "些最早": 1, "些领": 1, "些领域": 1,
+		"交": 1, "交谈": 1, "交谈 ": 1, "产": 1, "产品": 1, "产品明": 1, "人们": 1, "人们可": 1,
+		"人借": 1, "人借阅": 1, "人员": 1, "人员用": 1, "人士": 1, "人士认": 1, "人失": 1, "人失望": 1,
+		"人来": 1, "人来说": 1, "人正": 1, "人正用": 1, "人注": 1, "人注意": 1, "人都": 1, "人都想": 1,
+		"亿": 1, "亿元": 1, "亿元修": 1, "什么有": 1, "什么钱": 1, "从来": 1, "从来都": 1, "从河": 1,
+		"从河上": 1, "他们的": 1, "他们说": 1, "他相": 1, "他相信": 1, "令": 1, "令人": 1, "令人失": 1,
+		"以及": 1, "以及和": 1, "以来": 1, "以来 ": 1, "以闻": 1, "以闻到": 1, "们一": 1, "们一直": 1,
+		"们刚": 1, "们刚搬": 1, "们可": 1, "们可以": 1, "们在": 1, "们在公": 1, "们坐": 1, "们坐在": 1,
+		"们没": 1, "们没什": 1, "们的": 1, "们的父": 1, "们说": 1, "们说这": 1, "价": 1, "价大": 1,
+		"价大幅": 1, "企": 1, "企业": 1, "企业进": 1, "会": 1, "会好": 1, "会好转": 1, "但他": 1,
+		"但他相": 1, "但过": 1, "但过得": 1, "位 ": 1, "位于": 1, "位于街": 1, "位老": 1, "位老人": 1,
+		"住": 1, "住在": 1, "住在一": 1, "使": 1, "使进": 1, "使进步": 1, "信": 1, "信 ": 1,
+		"修": 1, "修建": 1, "修建新": 1, "候": 1, "候 ": 1, "借": 1, "借阅": 1, "借阅的": 1,
+		"元": 1, "元修": 1, "元修建": 1, "光": 1, "光从": 1, "光从河": 1, "入": 1, "入更": 1,
+	},
+}
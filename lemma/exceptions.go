@@ -0,0 +1,164 @@
+package lemma
+
+// irregularVerbs lists the base form, past tense, and past participle of
+// English verbs that aren't inflected with "-ed." Alternative forms are
+// separated by "/".
+var irregularVerbs = []string{
+	"arise arose arisen", "awake awoke awoken", "be was/were been",
+	"bear bore borne/born", "beat beat beaten", "become became become",
+	"begin began begun", "bend bent bent", "bet bet bet", "bid bid bid",
+	"bind bound bound", "bite bit bitten", "bleed bled bled",
+	"blow blew blown", "break broke broken", "breed bred bred",
+	"bring brought brought", "broadcast broadcast broadcast",
+	"build built built", "burn burned/burnt burned/burnt",
+	"burst burst burst", "buy bought bought", "cast cast cast",
+	"catch caught caught", "choose chose chosen", "cling clung clung",
+	"come came come", "cost cost cost", "creep crept crept", "cut cut cut",
+	"deal dealt dealt", "dig dug dug", "do did done", "draw drew drawn",
+	"dream dreamed/dreamt dreamed/dreamt", "drink drank drunk",
+	"drive drove driven", "dwell dwelt/dwelled dwelt/dwelled",
+	"eat ate eaten", "fall fell fallen", "feed fed fed", "feel felt felt",
+	"fight fought fought", "find found found", "flee fled fled",
+	"fling flung flung", "fly flew flown", "forbid forbade forbidden",
+	"forecast forecast forecast", "foresee foresaw foreseen",
+	"forget forgot forgotten", "forgive forgave forgiven",
+	"freeze froze frozen", "get got got/gotten", "give gave given",
+	"go went gone", "grind ground ground", "grow grew grown",
+	"hang hung/hanged hung/hanged", "have had had", "hear heard heard",
+	"hide hid hidden", "hit hit hit", "hold held held", "hurt hurt hurt",
+	"keep kept kept", "kneel knelt/kneeled knelt/kneeled",
+	"know knew known", "lay laid laid", "lead led led",
+	"lean leaned/leant leaned/leant", "leap leaped/leapt leaped/leapt",
+	"learn learned/learnt learned/learnt", "leave left left",
+	"lend lent lent", "let let let", "lie lay lain", "light lit/lighted lit/lighted",
+	"lose lost lost", "make made made", "mean meant meant", "meet met met",
+	"mislead misled misled", "misunderstand misunderstood misunderstood",
+	"mistake mistook mistaken", "overcome overcame overcome",
+	"oversee oversaw overseen", "override overrode overridden",
+	"overtake overtook overtaken", "overthrow overthrew overthrown",
+	"pay paid paid", "prove proved proven/proved", "put put put",
+	"quit quit quit", "read read read", "rebuild rebuilt rebuilt",
+	"rethink rethought rethought", "rewrite rewrote rewritten",
+	"rid rid rid", "ride rode ridden", "ring rang rung", "rise rose risen",
+	"run ran run", "say said said", "see saw seen", "seek sought sought",
+	"sell sold sold", "send sent sent", "set set set",
+	"sew sewed sewn/sewed", "shake shook shaken", "shed shed shed",
+	"shine shone shone", "shoot shot shot", "show showed shown",
+	"shrink shrank shrunk", "shut shut shut", "sing sang sung",
+	"sink sank sunk", "sit sat sat", "sleep slept slept", "slide slid slid",
+	"sling slung slung", "slit slit slit",
+	"smell smelled/smelt smelled/smelt", "speak spoke spoken",
+	"speed sped sped", "spell spelled/spelt spelled/spelt",
+	"spend spent spent", "spill spilled/spilt spilled/spilt",
+	"spin spun spun", "spit spat/spit spat/spit", "split split split",
+	"spoil spoiled/spoilt spoiled/spoilt", "spread spread spread",
+	"spring sprang sprung", "stand stood stood", "steal stole stolen",
+	"stick stuck stuck", "sting stung stung", "stink stank stunk",
+	"stride strode stridden", "strike struck struck/stricken",
+	"string strung strung", "strive strove striven", "swear swore sworn",
+	"sweep swept swept", "swell swelled swollen/swelled", "swim swam swum",
+	"swing swung swung", "take took taken", "teach taught taught",
+	"tear tore torn", "tell told told", "think thought thought",
+	"throw threw thrown", "thrust thrust thrust", "tread trod trodden",
+	"undergo underwent undergone", "understand understood understood",
+	"undertake undertook undertaken", "undo undid undone",
+	"uphold upheld upheld", "upset upset upset", "wake woke woken",
+	"wear wore worn", "weave wove woven", "weep wept wept", "win won won",
+	"wind wound wound", "withdraw withdrew withdrawn",
+	"withhold withheld withheld", "withstand withstood withstood",
+	"wring wrung wrung", "write wrote written"}
+
+// verbExceptions are inflected verbs whose lemmas can't be found by removing
+// a suffix, keyed by their Penn Treebank tag (in addition to the past tenses
+// and participles of irregularVerbs, which are added to both VBD and VBN).
+var verbExceptions = map[string]map[string]string{
+	"VBD": {
+		// Regular verbs whose stems the suffix rules get wrong.
+		"created": "create", "completed": "complete", "competed": "compete",
+		"deleted": "delete", "invited": "invite", "excited": "excite",
+		"united": "unite", "ignited": "ignite", "promoted": "promote",
+		"devoted": "devote", "quoted": "quote", "ignored": "ignore",
+		"restored": "restore", "explored": "explore", "adored": "adore",
+		"postponed": "postpone", "compiled": "compile", "escaped": "escape",
+		"focused": "focus", "biased": "bias", "added": "add",
+		"controlled": "control", "owed": "owe", "eyed": "eye",
+		"welcomed": "welcome"},
+	"VBG": {
+		"being": "be", "dying": "die", "lying": "lie", "tying": "tie",
+		"vying": "vie", "creating": "create", "completing": "complete",
+		"competing": "compete", "deleting": "delete", "inviting": "invite",
+		"exciting": "excite", "uniting": "unite", "igniting": "ignite",
+		"promoting": "promote", "devoting": "devote", "quoting": "quote",
+		"ignoring": "ignore", "restoring": "restore", "exploring": "explore",
+		"adoring": "adore", "postponing": "postpone", "compiling": "compile",
+		"escaping": "escape", "focusing": "focus", "owing": "owe",
+		"eyeing": "eye", "welcoming": "welcome"},
+	"VBZ": {
+		"is": "be", "'s": "be", "has": "have", "does": "do", "goes": "go",
+		"aches": "ache", "focuses": "focus", "biases": "bias"},
+	"VBP": {
+		"am": "be", "are": "be", "'m": "be", "'re": "be", "'ve": "have"},
+}
+
+// nounExceptions are irregular plural nouns.
+var nounExceptions = map[string]string{
+	"men": "man", "women": "woman", "children": "child", "people": "person",
+	"mice": "mouse", "lice": "louse", "geese": "goose", "feet": "foot",
+	"teeth": "tooth", "oxen": "ox", "dice": "die", "pence": "penny",
+	"brethren": "brother",
+
+	// Nouns with the same singular and plural forms.
+	"series": "series", "species": "species", "sheep": "sheep",
+	"deer": "deer", "fish": "fish", "moose": "moose", "aircraft": "aircraft",
+	"offspring": "offspring", "means": "means", "news": "news",
+	"clothes": "clothes",
+
+	// Latin and Greek plurals.
+	"analyses": "analysis", "axes": "axis", "crises": "crisis",
+	"diagnoses": "diagnosis", "emphases": "emphasis", "hypotheses": "hypothesis",
+	"oases": "oasis", "parentheses": "parenthesis", "syntheses": "synthesis",
+	"theses": "thesis", "cacti": "cactus", "fungi": "fungus",
+	"nuclei": "nucleus", "radii": "radius", "stimuli": "stimulus",
+	"syllabi": "syllabus", "alumni": "alumnus", "foci": "focus",
+	"appendices": "appendix", "indices": "index", "matrices": "matrix",
+	"vertices": "vertex", "criteria": "criterion", "phenomena": "phenomenon",
+	"bacteria": "bacterium", "curricula": "curriculum",
+	"memoranda": "memorandum", "millennia": "millennium", "strata": "stratum",
+	"formulae": "formula", "antennae": "antenna", "larvae": "larva",
+	"vertebrae": "vertebra",
+
+	// Nouns ending in "f" or "fe" whose plurals end in "ves."
+	"calves": "calf", "elves": "elf", "halves": "half", "hooves": "hoof",
+	"knives": "knife", "leaves": "leaf", "lives": "life", "loaves": "loaf",
+	"scarves": "scarf", "selves": "self", "sheaves": "sheaf",
+	"shelves": "shelf", "thieves": "thief", "wives": "wife",
+	"wolves": "wolf",
+
+	// Nouns whose plurals look like they have a different suffix.
+	"shoes": "shoe", "toes": "toe", "canoes": "canoe", "foes": "foe",
+	"oboes": "oboe", "movies": "movie", "cookies": "cookie",
+	"zombies": "zombie", "calories": "calorie", "prairies": "prairie",
+	"rookies": "rookie", "brownies": "brownie", "smoothies": "smoothie",
+	"genies": "genie", "aunties": "auntie", "selfies": "selfie",
+	"buses": "bus", "bonuses": "bonus", "viruses": "virus",
+	"campuses": "campus", "statuses": "status", "censuses": "census",
+	"surpluses": "surplus", "choruses": "chorus", "circuses": "circus",
+	"focuses": "focus", "geniuses": "genius", "consensuses": "consensus",
+	"gases": "gas", "aliases": "alias", "atlases": "atlas", "biases": "bias",
+	"canvases": "canvas", "lenses": "lens", "irises": "iris",
+	"aches": "ache", "headaches": "headache", "niches": "niche",
+	"caches": "cache", "avalanches": "avalanche", "mustaches": "mustache",
+	"moustaches": "moustache"}
+
+// adjectiveExceptions are irregular comparative and superlative adjectives.
+var adjectiveExceptions = map[string]string{
+	"better": "good", "best": "good", "worse": "bad", "worst": "bad",
+	"further": "far", "furthest": "far", "farther": "far", "farthest": "far",
+	"less": "little", "least": "little", "elder": "old", "eldest": "old",
+	"freer": "free", "freest": "free"}
+
+// adverbExceptions are irregular comparative and superlative adverbs.
+var adverbExceptions = map[string]string{
+	"better": "well", "best": "well", "worse": "badly", "worst": "badly",
+	"further": "far", "furthest": "far", "farther": "far", "farthest": "far",
+	"less": "little", "least": "little"}
@@ -0,0 +1,304 @@
+/*
+Package lemma implements functions for finding the dictionary form (lemma) of
+English words (e.g., "was" -> "be", "mice" -> "mouse", or "better" ->
+"good").
+
+Unlike a stemmer, a Lemmatizer uses each word's part of speech--as assigned
+by, for example, tag.PerceptronTagger--to decide which rules apply, and it
+consults a dictionary of irregular forms before falling back to removing
+suffixes.
+*/
+package lemma
+
+import (
+	"strings"
+
+	"github.com/jdkato/prose/tag"
+)
+
+// A Token is a tagged word along with its lemma.
+type Token struct {
+	tag.Token
+	Lemma string
+}
+
+// Lemmatizer finds the lemmas of tagged words.
+//
+// Plural nouns (NNS), inflected verbs (VBD, VBG, VBN, and VBZ), and
+// comparative and superlative adjectives (JJR and JJS) and adverbs (RBR and
+// RBS) have their suffixes removed; other words are only converted to
+// lowercase, except for proper nouns (NNP and NNPS), which are returned
+// unchanged.
+type Lemmatizer struct {
+	exceptions map[string]map[string]string // [tag][word]lemma
+	verbs      map[string]bool              // the base forms of irregular verbs
+}
+
+// NewLemmatizer creates a new Lemmatizer with a dictionary of common irregular
+// forms.
+func NewLemmatizer() *Lemmatizer {
+	l := Lemmatizer{
+		exceptions: make(map[string]map[string]string),
+		verbs:      make(map[string]bool)}
+
+	for _, parts := range irregularVerbs {
+		forms := strings.Fields(parts)
+		l.verbs[forms[0]] = true
+		for _, form := range forms[1:] {
+			for _, word := range strings.Split(form, "/") {
+				l.AddException("VBD", word, forms[0])
+				l.AddException("VBN", word, forms[0])
+			}
+		}
+	}
+	for pos, words := range verbExceptions {
+		for word, lemma := range words {
+			l.AddException(pos, word, lemma)
+			if pos == "VBD" {
+				l.AddException("VBN", word, lemma)
+			}
+		}
+	}
+	for _, pos := range []string{"NNS", "JJR", "JJS", "RBR", "RBS"} {
+		words := nounExceptions
+		if strings.HasPrefix(pos, "JJ") {
+			words = adjectiveExceptions
+		} else if strings.HasPrefix(pos, "RB") {
+			words = adverbExceptions
+		}
+		for word, lemma := range words {
+			l.AddException(pos, word, lemma)
+		}
+	}
+
+	return &l
+}
+
+// AddException adds an irregular form to the Lemmatizer's dictionary: word,
+// when it's tagged with pos, will have the given lemma. Words are matched
+// case-insensitively.
+func (l *Lemmatizer) AddException(pos, word, lemma string) {
+	if _, found := l.exceptions[pos]; !found {
+		l.exceptions[pos] = make(map[string]string)
+	}
+	l.exceptions[pos][strings.ToLower(word)] = lemma
+}
+
+// Lemmatize returns the lemma of each token in tokens.
+func (l Lemmatizer) Lemmatize(tokens []tag.Token) []Token {
+	lemmas := make([]Token, len(tokens))
+	for i, tok := range tokens {
+		lemmas[i] = Token{Token: tok, Lemma: l.Lemma(tok.Text, tok.Tag)}
+	}
+	return lemmas
+}
+
+// Lemma returns the lemma of word, which has the Penn Treebank tag pos.
+func (l Lemmatizer) Lemma(word, pos string) string {
+	if pos == "NNP" || pos == "NNPS" {
+		return word
+	}
+
+	word = strings.ToLower(word)
+	if lemma, found := l.exceptions[pos][word]; found {
+		return lemma
+	}
+
+	switch pos {
+	case "NNS":
+		return noun(word)
+	case "VBZ":
+		return thirdPerson(word)
+	case "VBD", "VBN":
+		return l.pastTense(word)
+	case "VBG":
+		return l.gerund(word)
+	case "JJR", "RBR":
+		return comparative(word, "er")
+	case "JJS", "RBS":
+		return comparative(word, "est")
+	}
+	return word
+}
+
+// noun removes the plural suffix from word.
+func noun(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "selves"):
+		return strings.TrimSuffix(word, "selves") + "self"
+	case len(word) > 3 && strings.HasSuffix(word, "men") &&
+		strings.IndexByte("io", word[len(word)-4]) < 0:
+		return strings.TrimSuffix(word, "men") + "man"
+	case strings.HasSuffix(word, "zzes"):
+		return strings.TrimSuffix(word, "zes")
+	}
+	return removeS(word)
+}
+
+// thirdPerson removes the suffix from a verb in the third-person singular
+// present tense (e.g., "makes" or "tries").
+func thirdPerson(word string) string {
+	if len(word) > 4 && strings.HasSuffix(word, "ies") {
+		return strings.TrimSuffix(word, "ies") + "y"
+	}
+	return removeS(word)
+}
+
+// pastTense removes the suffix from a regular verb in the past tense (or the
+// past participle).
+func (l Lemmatizer) pastTense(word string) string {
+	switch {
+	case !strings.HasSuffix(word, "ed"):
+		return word
+	case strings.HasSuffix(word, "ied"):
+		if len(word) > 4 {
+			return strings.TrimSuffix(word, "ied") + "y"
+		}
+		return strings.TrimSuffix(word, "d")
+	case strings.HasSuffix(word, "eed"):
+		return strings.TrimSuffix(word, "d")
+	}
+	return l.verb(word, "ed")
+}
+
+// gerund removes the suffix from a present participle.
+func (l Lemmatizer) gerund(word string) string {
+	if !strings.HasSuffix(word, "ing") {
+		return word
+	}
+	return l.verb(word, "ing")
+}
+
+// verb removes suffix (either "ed" or "ing") from word, which may also require
+// undoubling a consonant ("stopped" -> "stop") or restoring an "e" ("hoped"
+// -> "hope"). The base forms of irregular verbs (e.g., "bring" rather than
+// "bringe") are preferred.
+func (l Lemmatizer) verb(word, suffix string) string {
+	stem := strings.TrimSuffix(word, suffix)
+	if !strings.ContainsAny(stem, "aeiouy") {
+		return word
+	} else if l.verbs[stem] {
+		return stem
+	} else if l.verbs[stem+"e"] {
+		return stem + "e"
+	}
+	return restore(stem)
+}
+
+// comparative removes suffix (either "er" or "est") from word.
+func comparative(word, suffix string) string {
+	stem := strings.TrimSuffix(word, suffix)
+	if stem == word || !strings.ContainsAny(stem, "aeiouy") {
+		return word
+	} else if strings.HasSuffix(stem, "i") && len(stem) > 2 {
+		return strings.TrimSuffix(stem, "i") + "y"
+	}
+	return restore(stem)
+}
+
+// removeS removes the plural (or third-person singular) "-s" or "-es" from
+// word.
+func removeS(word string) string {
+	for _, suffix := range []string{"sses", "shes", "ches", "xes", "oes"} {
+		if strings.HasSuffix(word, suffix) {
+			return strings.TrimSuffix(word, "es")
+		}
+	}
+	for _, suffix := range []string{"ss", "us", "is", "'s"} {
+		if strings.HasSuffix(word, suffix) {
+			return word
+		}
+	}
+	return strings.TrimSuffix(word, "s")
+}
+
+// eEndings are the endings of a stem, following a consonant, that suggest a
+// final "e" has been removed (e.g., "relat" from "related").
+var eEndings = []string{
+	"at", "ut", "ad", "ed", "id", "od", "ud", "ag", "eg", "ig", "og", "ug",
+	"ak", "ek", "ik", "ok", "uk", "ab", "eb", "ib", "ob", "ub", "am", "em",
+	"im", "um", "in", "ar", "ir", "ur"}
+
+// restore undoubles the final consonant of a stem or restores its final
+// "e," if either seems to have been changed by adding a suffix.
+func restore(stem string) string {
+	n := len(stem)
+	if n < 2 {
+		return stem
+	}
+
+	last := stem[n-1]
+	if n >= 4 && stem[n-2] == last && strings.IndexByte("bdgmnprt", last) >= 0 {
+		return stem[:n-1]
+	} else if strings.HasSuffix(stem, "ell") || strings.HasSuffix(stem, "oll") {
+		if syllables(stem) > 1 {
+			return stem[:n-1]
+		}
+		return stem
+	}
+
+	switch {
+	case isShort(stem):
+	case strings.IndexByte("cuv", last) >= 0:
+	case (last == 's' || last == 'z') && stem[n-2] != last:
+	case last == 'l' && !isVowel(stem, n-2) && strings.IndexByte("lrw", stem[n-2]) < 0:
+	case last == 'g' && !isVowel(stem, n-2) && strings.IndexByte("gn", stem[n-2]) < 0:
+	case strings.HasSuffix(stem, "ang") || strings.HasSuffix(stem, "eng") ||
+		strings.HasSuffix(stem, "ung"):
+	case strings.HasSuffix(stem, "th") && n > 2 && isVowel(stem, n-3):
+	case n > 2 && !isVowel(stem, n-3) && hasAnySuffix(stem, eEndings):
+	case strings.HasSuffix(stem, "iat"):
+	default:
+		return stem
+	}
+	return stem + "e"
+}
+
+// isVowel determines if the ith letter of word is a vowel: a, e, i, o, u
+// (except after q), or y (when it follows a consonant).
+func isVowel(word string, i int) bool {
+	switch word[i] {
+	case 'a', 'e', 'i', 'o':
+		return true
+	case 'u':
+		return i == 0 || word[i-1] != 'q'
+	case 'y':
+		return i > 0 && !isVowel(word, i-1)
+	}
+	return false
+}
+
+// syllables returns the number of groups of vowels in word.
+func syllables(word string) int {
+	count := 0
+	for i := range word {
+		if isVowel(word, i) && (i == 0 || !isVowel(word, i-1)) {
+			count++
+		}
+	}
+	return count
+}
+
+// isShort determines if word has one syllable that ends with a short vowel:
+// a vowel followed by a consonant other than w, x, or y and preceded by a
+// consonant (or nothing).
+func isShort(word string) bool {
+	n := len(word)
+	if n < 2 || syllables(word) != 1 || strings.IndexByte("wxy", word[n-1]) >= 0 {
+		return false
+	}
+	return isVowel(word, n-2) && !isVowel(word, n-1) &&
+		(n == 2 || !isVowel(word, n-3))
+}
+
+// hasAnySuffix determines if word ends with any of suffixes.
+func hasAnySuffix(word string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
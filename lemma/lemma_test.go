@@ -0,0 +1,70 @@
+package lemma
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/jdkato/prose/tag"
+	"github.com/jdkato/prose/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+var testdata = filepath.Join("..", "testdata")
+
+type testCase struct {
+	Word  string
+	Tag   string
+	Lemma string
+}
+
+func TestLemma(t *testing.T) {
+	tests := make([]testCase, 0)
+	cases := util.ReadDataFile(filepath.Join(testdata, "lemma.json"))
+	util.CheckError(json.Unmarshal(cases, &tests))
+
+	l := NewLemmatizer()
+	for _, test := range tests {
+		assert.Equal(t, test.Lemma, l.Lemma(test.Word, test.Tag), test.Word+"/"+test.Tag)
+	}
+}
+
+func TestLemmatize(t *testing.T) {
+	tokens := []tag.Token{
+		{Text: "The", Tag: "DT"}, {Text: "mice", Tag: "NNS"},
+		{Text: "were", Tag: "VBD"}, {Text: "running", Tag: "VBG"}}
+	lemmas := NewLemmatizer().Lemmatize(tokens)
+	assert.Equal(t, len(tokens), len(lemmas))
+	for i, lemma := range []string{"the", "mouse", "be", "run"} {
+		assert.Equal(t, tokens[i], lemmas[i].Token)
+		assert.Equal(t, lemma, lemmas[i].Lemma)
+	}
+}
+
+func TestAddException(t *testing.T) {
+	l := NewLemmatizer()
+	assert.Equal(t, "octopuse", l.Lemma("octopuses", "NNS"))
+	l.AddException("NNS", "Octopuses", "octopus")
+	assert.Equal(t, "octopus", l.Lemma("octopuses", "NNS"))
+	assert.Equal(t, "octopuses", l.Lemma("octopuses", "NN"))
+}
+
+func ExampleLemmatizer() {
+	words := tokenize.NewTreebankWordTokenizer().Tokenize("The children were running faster than their dogs.")
+	tagger := tag.MustNewPerceptronTagger()
+	for _, tok := range NewLemmatizer().Lemmatize(tagger.Tag(words)) {
+		fmt.Println(tok.Text, tok.Tag, tok.Lemma)
+	}
+	// Output:
+	// The DT the
+	// children NNS child
+	// were VBD be
+	// running VBG run
+	// faster RBR fast
+	// than IN than
+	// their PRP$ their
+	// dogs NNS dog
+	// . . .
+}
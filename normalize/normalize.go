@@ -0,0 +1,157 @@
+/*
+Package normalize implements functions for cleaning up text before it's
+tokenized: applying Unicode normalization, folding typographic punctuation,
+removing invisible characters, and so on.
+
+Normalization is performed by a chain of Steps, each of which describes its
+changes as a list of Edits. This allows a Normalizer to keep track of where
+each part of its output came from, so that the locations of tokens found in
+normalized text can be mapped back to the original text:
+
+    n := normalize.NewNormalizer()
+    text, offsets := n.Normalize(raw)
+    for _, span := range tokenizer.TokenizeSpans(text) {
+        original := offsets.MapSpan(span)
+        ...
+    }
+*/
+package normalize
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/jdkato/prose/tokenize"
+)
+
+// An Edit replaces the bytes text[Start:End] with Text.
+type Edit struct {
+	Start, End int
+	Text       string
+}
+
+// A Step is a single stage of normalization. It returns the Edits needed to
+// normalize text, which must be sorted by their Start and may not overlap.
+type Step func(text string) []Edit
+
+// DefaultSteps are the Steps used by a Normalizer if none are specified.
+var DefaultSteps = []Step{
+	NFKC, FoldPunctuation, StripZeroWidth, JoinHyphenated, CollapseWhitespace}
+
+// Normalizer applies a sequence of Steps to text.
+type Normalizer struct {
+	Steps []Step
+}
+
+// NewNormalizer creates a new Normalizer that applies the given steps in
+// order (or DefaultSteps, if there aren't any).
+func NewNormalizer(steps ...Step) *Normalizer {
+	if len(steps) == 0 {
+		steps = DefaultSteps
+	}
+	return &Normalizer{Steps: steps}
+}
+
+// Normalize applies the Normalizer's Steps to text, returning the result and
+// an OffsetMap from the result back to text.
+func (n Normalizer) Normalize(text string) (string, *OffsetMap) {
+	m := newOffsetMap(text)
+	for _, step := range n.Steps {
+		text = m.apply(text, step(text))
+	}
+	return text, m
+}
+
+// An OffsetMap maps locations in normalized text to locations in the text it
+// was created from.
+type OffsetMap struct {
+	raw          string
+	starts, ends []int // the part of raw that each normalized byte came from
+}
+
+func newOffsetMap(raw string) *OffsetMap {
+	m := OffsetMap{raw: raw}
+	for i := 0; i < len(raw); i++ {
+		m.starts = append(m.starts, i)
+		m.ends = append(m.ends, i+1)
+	}
+	return &m
+}
+
+// Span returns the location in the original text of the normalized bytes
+// [start:end].
+//
+// Where a Step has replaced some text, every byte of the replacement is
+// mapped to all of the text it replaced. Offsets outside of the normalized
+// text are clamped to its bounds.
+func (m *OffsetMap) Span(start, end int) (int, int) {
+	start, end = m.clamp(start), m.clamp(end)
+	if start >= end {
+		pos := m.position(start)
+		return pos, pos
+	}
+	return m.starts[start], m.ends[end-1]
+}
+
+// MapSpan converts span, which refers to the normalized text, into a Span of
+// the original text.
+func (m *OffsetMap) MapSpan(span tokenize.Span) tokenize.Span {
+	start, end := m.Span(span.Start, span.End)
+	runeStart := utf8.RuneCountInString(m.raw[:start])
+	return tokenize.Span{
+		Text: m.raw[start:end], Start: start, End: end, RuneStart: runeStart,
+		RuneEnd: runeStart + utf8.RuneCountInString(m.raw[start:end])}
+}
+
+// clamp limits i to the bounds of the normalized text, [0, len].
+func (m *OffsetMap) clamp(i int) int {
+	if i < 0 {
+		return 0
+	} else if i > len(m.starts) {
+		return len(m.starts)
+	}
+	return i
+}
+
+// position returns the location in the original text of the point before
+// the ith normalized byte.
+func (m *OffsetMap) position(i int) int {
+	if i < len(m.starts) {
+		return m.starts[i]
+	} else if len(m.ends) > 0 {
+		return m.ends[len(m.ends)-1]
+	}
+	return len(m.raw)
+}
+
+// apply performs edits on text (whose bytes are currently mapped by m),
+// updating m to map the result.
+func (m *OffsetMap) apply(text string, edits []Edit) string {
+	if len(edits) == 0 {
+		return text
+	}
+
+	var buf bytes.Buffer
+	starts, ends := []int{}, []int{}
+	keep := func(from, to int) {
+		buf.WriteString(text[from:to])
+		starts = append(starts, m.starts[from:to]...)
+		ends = append(ends, m.ends[from:to]...)
+	}
+
+	last := 0
+	for _, edit := range edits {
+		keep(last, edit.Start)
+		start, end := m.Span(edit.Start, edit.End)
+		buf.WriteString(edit.Text)
+		for i := 0; i < len(edit.Text); i++ {
+			starts = append(starts, start)
+			ends = append(ends, end)
+		}
+		last = edit.End
+	}
+	keep(last, len(text))
+
+	m.starts, m.ends = starts, ends
+	return buf.String()
+}
@@ -0,0 +1,93 @@
+package normalize
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleNormalizer() {
+	n := NewNormalizer()
+	text, _ := n.Normalize("“Efﬁcient” text nor-\nmalization — in one\u200b  pass…")
+	fmt.Println(text)
+	// Output: "Efficient" text normalization - in one pass...
+}
+
+func TestSteps(t *testing.T) {
+	tests := []struct {
+		step     Step
+		text     string
+		expected string
+	}{
+		{NFKC, "ﬁne caf\u00e9 ２０１８", "fine caf\u00e9 2018"},
+		{NFKC, "cafe\u0301", "caf\u00e9"},
+		{FoldPunctuation, "‘It’s’ – “quoted”…", `'It's' - "quoted"...`},
+		{StripZeroWidth, "\ufeffzero\u200bwidth\u00ad", "zerowidth"},
+		{JoinHyphenated, "hyph-\n  enated, well-\n\nknown, self-\nmade, 1-\n2",
+			"hyphenated, well-\n\nknown, selfmade, 1-\n2"},
+		{CollapseWhitespace, "a \t b\n\n\nc \n d e", "a b\n\nc\nd e"},
+	}
+	for _, test := range tests {
+		text, _ := NewNormalizer(test.step).Normalize(test.text)
+		assert.Equal(t, test.expected, text)
+	}
+}
+
+func TestOffsetMap(t *testing.T) {
+	raw := "The  “nai\u0308ve” caf\u00e9 re-\nopened\u200b…"
+	text, offsets := NewNormalizer().Normalize(raw)
+	assert.Equal(t, "The \"na\u00efve\" caf\u00e9 reopened...", text)
+
+	for _, test := range []struct{ normalized, original string }{
+		{"The", "The"},
+		{"na\u00efve", "nai\u0308ve"},
+		{"\"na\u00efve\"", "“nai\u0308ve”"},
+		{"caf\u00e9", "caf\u00e9"},
+		{"reopened", "re-\nopened"},
+		{"...", "…"},
+		{"\u00ef", "i\u0308"},
+	} {
+		start := strings.Index(text, test.normalized)
+		s, e := offsets.Span(start, start+len(test.normalized))
+		assert.Equal(t, test.original, raw[s:e])
+	}
+
+	s, e := offsets.Span(len(text), len(text))
+	assert.Equal(t, []int{len(raw), len(raw)}, []int{s, e})
+
+	// Spans that end at (or past) the end of the text.
+	s, e = offsets.Span(len(text)-3, len(text))
+	assert.Equal(t, "…", raw[s:e])
+	s, e = offsets.Span(len(text)-3, len(text)+10)
+	assert.Equal(t, "…", raw[s:e])
+	s, e = offsets.Span(-5, 3)
+	assert.Equal(t, "The", raw[s:e])
+	s, e = offsets.Span(len(text)+1, len(text)+2)
+	assert.Equal(t, []int{len(raw), len(raw)}, []int{s, e})
+
+	span := offsets.MapSpan(tokenize.Span{Start: len(text) - 3, End: len(text) + 1})
+	assert.Equal(t, "…", span.Text)
+}
+
+func TestMapSpan(t *testing.T) {
+	raw := "“Über”  co-\noperation…"
+	text, offsets := NewNormalizer().Normalize(raw)
+
+	tokenizer := tokenize.NewTreebankWordTokenizer()
+	spans := []tokenize.Span{}
+	for _, span := range tokenizer.TokenizeSpans(text) {
+		spans = append(spans, offsets.MapSpan(span))
+	}
+
+	expected := []tokenize.Span{
+		{Text: "“", Start: 0, End: 3, RuneStart: 0, RuneEnd: 1},
+		{Text: "Über", Start: 3, End: 8, RuneStart: 1, RuneEnd: 5},
+		{Text: "”", Start: 8, End: 11, RuneStart: 5, RuneEnd: 6},
+		{Text: "co-\noperation", Start: 13, End: 26, RuneStart: 8, RuneEnd: 21},
+		{Text: "…", Start: 26, End: 29, RuneStart: 21, RuneEnd: 22},
+	}
+	assert.Equal(t, expected, spans)
+}
@@ -0,0 +1,89 @@
+package normalize
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NFKC applies Unicode Normalization Form KC, which (among other things)
+// combines accents with the letters they follow and replaces compatibility
+// characters such as ligatures and full-width letters.
+func NFKC(text string) []Edit {
+	edits := []Edit{}
+
+	var it norm.Iter
+	it.InitString(norm.NFKC, text)
+	for !it.Done() {
+		start := it.Pos()
+		segment := string(it.Next())
+		if end := it.Pos(); segment != text[start:end] {
+			edits = append(edits, Edit{Start: start, End: end, Text: segment})
+		}
+	}
+	return edits
+}
+
+// FoldPunctuation replaces typographic quotes, dashes, and ellipses with their
+// ASCII equivalents.
+var FoldPunctuation = MapRunes(map[rune]string{
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '“': `"`, '”': `"`, '„': `"`,
+	'‟': `"`, '‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-",
+	'−': "-", '…': "..."})
+
+// StripZeroWidth removes invisible characters: zero-width spaces and
+// (non-)joiners, word joiners, byte order marks, and soft hyphens.
+var StripZeroWidth = MapRunes(map[rune]string{
+	'\u200b': "", '\u200c': "", '\u200d': "", '\u2060': "", '\ufeff': "",
+	'\u00ad': ""})
+
+// MapRunes creates a Step that replaces each rune in replacements with its
+// associated string.
+func MapRunes(replacements map[rune]string) Step {
+	return func(text string) []Edit {
+		edits := []Edit{}
+		for i, r := range text {
+			if s, found := replacements[r]; found {
+				edits = append(edits, Edit{
+					Start: i, End: i + utf8.RuneLen(r), Text: s})
+			}
+		}
+		return edits
+	}
+}
+
+var hyphenatedLineBreak = regexp.MustCompile(`\pL(-[ \t]*\r?\n[ \t]*)\pL`)
+
+// JoinHyphenated rejoins words that have been hyphenated across lines (e.g.,
+// "normal-\nize" becomes "normalize").
+func JoinHyphenated(text string) []Edit {
+	edits := []Edit{}
+	for _, m := range hyphenatedLineBreak.FindAllStringSubmatchIndex(text, -1) {
+		edits = append(edits, Edit{Start: m[2], End: m[3]})
+	}
+	return edits
+}
+
+var whitespace = regexp.MustCompile(`[\s\p{Zs}]+`)
+
+// CollapseWhitespace replaces each run of whitespace with a single space.
+// Line breaks are preserved: a run containing one line break is replaced with
+// "\n" and one containing more (i.e., a paragraph break) with "\n\n".
+func CollapseWhitespace(text string) []Edit {
+	edits := []Edit{}
+	for _, loc := range whitespace.FindAllStringIndex(text, -1) {
+		s := " "
+		switch n := strings.Count(text[loc[0]:loc[1]], "\n"); {
+		case n == 1:
+			s = "\n"
+		case n > 1:
+			s = "\n\n"
+		}
+		if text[loc[0]:loc[1]] != s {
+			edits = append(edits, Edit{Start: loc[0], End: loc[1], Text: s})
+		}
+	}
+	return edits
+}
@@ -0,0 +1,69 @@
+"""Generate langid/profiles.go from the samples in testdata/langid.
+
+This must extract n-grams in the same way as langid.NewProfile.
+"""
+import collections
+import glob
+import json
+import os
+import unicodedata
+
+MAX_N = 3
+PROFILE_SIZE = 500
+
+
+def is_letter(c):
+    return unicodedata.category(c)[0] in 'LM'
+
+
+def words(text):
+    word = ''
+    for c in text:
+        if is_letter(c):
+            word += c.lower()
+        elif word:
+            yield word
+            word = ''
+    if word:
+        yield word
+
+
+def profile(text):
+    counts = collections.Counter()
+    for word in words(text):
+        padded = ' ' + word + ' '
+        for n in range(1, MAX_N + 1):
+            for i in range(len(padded) - n + 1):
+                gram = padded[i:i + n]
+                if gram != ' ':
+                    counts[gram] += 1
+    ranked = sorted(counts.items(), key=lambda kv: (-kv[1], kv[0]))
+    return ranked[:PROFILE_SIZE]
+
+
+def entries(ranked):
+    line = '\t\t'
+    for gram, count in ranked:
+        entry = '{0}: {1},'.format(json.dumps(gram, ensure_ascii=False), count)
+        if len(line) + len(entry) > 78:
+            yield line.rstrip()
+            line = '\t\t'
+        line += entry + ' '
+    yield line.rstrip()
+
+
+samples = sorted(glob.glob(os.path.join('testdata', 'langid', '*.txt')))
+with open(os.path.join('langid', 'profiles.go'), 'w') as f:
+    f.write('// Code generated by scripts/langid.py. DO NOT EDIT.\n\n')
+    f.write('package langid\n\n')
+    f.write('// builtinProfiles are built from the samples in testdata/langid.\n')
+    f.write('var builtinProfiles = map[string]Profile{\n')
+    for path in samples:
+        lang = os.path.splitext(os.path.basename(path))[0]
+        with open(path, encoding='utf-8') as sample:
+            ranked = profile(sample.read())
+        f.write('\t"{0}": {{\n'.format(lang))
+        for line in entries(ranked):
+            f.write(line + '\n')
+        f.write('\t},\n')
+    f.write('}\n')
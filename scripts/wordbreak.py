@@ -0,0 +1,91 @@
+"""Generate tokenize/wordbreak_tables.go and testdata/WordBreakTest.txt.
+
+The data is taken from the Unicode Character Database. Set UCD_DIR to read
+the files from a local copy instead of downloading them.
+"""
+import os
+import re
+
+from urllib.request import urlopen
+
+VERSION = '15.0.0'
+UCD_URL = 'https://www.unicode.org/Public/{0}/ucd/'.format(VERSION)
+FILES = {
+    'WordBreakProperty.txt': 'auxiliary/WordBreakProperty.txt',
+    'WordBreakTest.txt': 'auxiliary/WordBreakTest.txt',
+    'emoji-data.txt': 'emoji/emoji-data.txt',
+}
+PROPERTIES = {
+    'CR': 'wbCR',
+    'LF': 'wbLF',
+    'Newline': 'wbNewline',
+    'Extend': 'wbExtend',
+    'ZWJ': 'wbZWJ',
+    'Regional_Indicator': 'wbRegionalIndicator',
+    'Format': 'wbFormat',
+    'Katakana': 'wbKatakana',
+    'Hebrew_Letter': 'wbHebrewLetter',
+    'ALetter': 'wbALetter',
+    'Single_Quote': 'wbSingleQuote',
+    'Double_Quote': 'wbDoubleQuote',
+    'MidNumLet': 'wbMidNumLet',
+    'MidLetter': 'wbMidLetter',
+    'MidNum': 'wbMidNum',
+    'Numeric': 'wbNumeric',
+    'ExtendNumLet': 'wbExtendNumLet',
+    'WSegSpace': 'wbWSegSpace',
+}
+LINE = re.compile(r'^([0-9A-F]+)(?:\.\.([0-9A-F]+))?\s*;\s*(\w+)')
+
+
+def read(name):
+    if os.environ.get('UCD_DIR'):
+        with open(os.path.join(os.environ['UCD_DIR'], name), 'rb') as f:
+            return f.read().decode('utf-8')
+    return urlopen(UCD_URL + FILES[name]).read().decode('utf-8')
+
+
+def parse(text, keep):
+    ranges = []
+    for line in text.splitlines():
+        m = LINE.match(line)
+        if m and m.group(3) in keep:
+            lo = int(m.group(1), 16)
+            hi = int(m.group(2) or m.group(1), 16)
+            ranges.append((lo, hi, m.group(3)))
+    return sorted(ranges)
+
+
+def merge(ranges):
+    merged = []
+    for lo, hi, prop in ranges:
+        if merged and merged[-1][2] == prop and merged[-1][1] + 1 == lo:
+            merged[-1] = (merged[-1][0], hi, prop)
+        else:
+            merged.append((lo, hi, prop))
+    return merged
+
+
+properties = merge(parse(read('WordBreakProperty.txt'), PROPERTIES))
+pictographic = merge(parse(read('emoji-data.txt'), {'Extended_Pictographic'}))
+
+with open(os.path.join('tokenize', 'wordbreak_tables.go'), 'w') as f:
+    f.write('// Code generated by scripts/wordbreak.py. DO NOT EDIT.\n\n')
+    f.write('package tokenize\n\n')
+    f.write('// wordBreakProperties is taken from\n')
+    f.write('// {0}{1}.\n'.format(UCD_URL, FILES['WordBreakProperty.txt']))
+    f.write('var wordBreakProperties = []wordBreakRange{\n')
+    for lo, hi, prop in properties:
+        f.write('\t{{0x{0:04X}, 0x{1:04X}, {2}}},\n'.format(
+            lo, hi, PROPERTIES[prop]))
+    f.write('}\n\n')
+    f.write('// extendedPictographic is taken from\n')
+    f.write('// {0}{1}.\n'.format(UCD_URL, FILES['emoji-data.txt']))
+    f.write('var extendedPictographic = []wordBreakRange{\n')
+    for lo, hi, _ in pictographic:
+        f.write('\t{{0x{0:04X}, 0x{1:04X}, wbExtendedPictographic}},\n'.format(
+            lo, hi))
+    f.write('}\n')
+
+with open(os.path.join('testdata', 'WordBreakTest.txt'), 'w') as f:
+    f.write(read('WordBreakTest.txt'))
@@ -0,0 +1,262 @@
+package stem
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// EnglishStemmer is a port of the Snowball English (Porter2) stemmer (see
+// https://snowballstem.org/algorithms/english/stemmer.html).
+type EnglishStemmer struct {
+}
+
+// NewEnglishStemmer is an EnglishStemmer constructor.
+func NewEnglishStemmer() *EnglishStemmer {
+	return new(EnglishStemmer)
+}
+
+// englishExceptions are stemmed irregularly (or not at all).
+var englishExceptions = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie",
+	"tying": "tie", "idly": "idl", "gently": "gentl", "ugly": "ugli",
+	"early": "earli", "only": "onli", "singly": "singl", "sky": "sky",
+	"news": "news", "howe": "howe", "atlas": "atlas", "cosmos": "cosmos",
+	"bias": "bias", "andes": "andes"}
+
+// englishInvariants are left alone once Step 1a has been applied.
+var englishInvariants = []string{
+	"inning", "outing", "canning", "herring", "earring", "proceed",
+	"exceed", "succeed"}
+
+var englishStep2 = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able",
+	"entli": "ent", "izer": "ize", "ization": "ize", "ational": "ate",
+	"ation": "ate", "ator": "ate", "alism": "al", "aliti": "al", "alli": "al",
+	"fulness": "ful", "ousli": "ous", "ousness": "ous", "iveness": "ive",
+	"iviti": "ive", "biliti": "ble", "bli": "ble", "ogi": "og", "fulli": "ful",
+	"lessli": "less", "li": ""}
+
+var englishStep2Suffixes = keys(englishStep2)
+
+var englishStep3 = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic",
+	"iciti": "ic", "ical": "ic", "ful": "", "ness": "", "ative": ""}
+
+var englishStep3Suffixes = keys(englishStep3)
+
+var englishStep4 = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+	"ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion"}
+
+// An englishWord is a word being stemmed along with the start of its R1 and
+// R2 regions.
+type englishWord struct {
+	text   string
+	p1, p2 int
+}
+
+// Stem reduces word, which is converted to lowercase, to its stem.
+func (s EnglishStemmer) Stem(word string) string {
+	word = strings.ToLower(word)
+	if stem, found := englishExceptions[word]; found {
+		return stem
+	} else if utf8.RuneCountInString(word) < 3 {
+		return word
+	}
+
+	w := newEnglishWord(word)
+	w.step0()
+	w.step1a()
+	for _, invariant := range englishInvariants {
+		if w.text == invariant {
+			return invariant
+		}
+	}
+	w.step1b()
+	w.step1c()
+	w.step2()
+	w.step3()
+	w.step4()
+	w.step5()
+
+	return strings.Replace(w.text, "Y", "y", -1)
+}
+
+func isEnglishVowel(r rune) bool {
+	return strings.ContainsRune("aeiouy", r)
+}
+
+// newEnglishWord removes any initial apostrophe from word, marks the y's that
+// are consonants (by converting them to Y), and finds R1 and R2.
+func newEnglishWord(word string) *englishWord {
+	b := []byte(strings.TrimPrefix(word, "'"))
+	for i := range b {
+		if b[i] == 'y' && (i == 0 || isEnglishVowel(rune(b[i-1]))) {
+			b[i] = 'Y'
+		}
+	}
+
+	w := englishWord{text: string(b)}
+	w.p1 = region(w.text, 0, isEnglishVowel)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(w.text, prefix) {
+			w.p1 = len(prefix)
+		}
+	}
+	w.p2 = region(w.text, w.p1, isEnglishVowel)
+
+	return &w
+}
+
+// inR1 determines if suffix, which w ends with, is in R1.
+func (w *englishWord) inR1(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p1
+}
+
+// inR2 determines if suffix, which w ends with, is in R2.
+func (w *englishWord) inR2(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p2
+}
+
+// endsShortSyllable determines if text ends in a short syllable: a vowel
+// followed by a non-vowel other than w, x, or Y and preceded by a non-vowel,
+// or a vowel at the beginning of the word followed by a non-vowel.
+func endsShortSyllable(text string) bool {
+	n := len(text)
+	if n == 2 {
+		return isEnglishVowel(rune(text[0])) && !isEnglishVowel(rune(text[1]))
+	}
+	return n > 2 && !isEnglishVowel(rune(text[n-3])) &&
+		isEnglishVowel(rune(text[n-2])) && !isEnglishVowel(rune(text[n-1])) &&
+		strings.IndexByte("wxY", text[n-1]) < 0
+}
+
+// step0 removes possessives.
+func (w *englishWord) step0() {
+	w.text = strings.TrimSuffix(w.text, longestSuffix(w.text, []string{
+		"'s'", "'s", "'"}))
+}
+
+// step1a removes plurals.
+func (w *englishWord) step1a() {
+	suffix := longestSuffix(w.text, []string{"sses", "ied", "ies", "s", "us", "ss"})
+	stem := strings.TrimSuffix(w.text, suffix)
+	switch suffix {
+	case "sses":
+		w.text = stem + "ss"
+	case "ied", "ies":
+		if utf8.RuneCountInString(stem) > 1 {
+			w.text = stem + "i"
+		} else {
+			w.text = stem + "ie"
+		}
+	case "s":
+		_, size := utf8.DecodeLastRuneInString(stem)
+		if strings.ContainsAny(stem[:len(stem)-size], "aeiouy") {
+			w.text = stem
+		}
+	}
+}
+
+// step1b removes past-tense and progressive endings.
+func (w *englishWord) step1b() {
+	suffix := longestSuffix(w.text, []string{
+		"eed", "eedly", "ed", "edly", "ing", "ingly"})
+	stem := strings.TrimSuffix(w.text, suffix)
+	switch suffix {
+	case "":
+	case "eed", "eedly":
+		if w.inR1(suffix) {
+			w.text = stem + "ee"
+		}
+	default:
+		if !strings.ContainsAny(stem, "aeiouy") {
+			return
+		}
+		w.text = stem
+		if longestSuffix(stem, []string{"at", "bl", "iz"}) != "" {
+			w.text += "e"
+		} else if longestSuffix(stem, []string{
+			"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"}) != "" {
+			w.text = stem[:len(stem)-1]
+		} else if len(stem) == w.p1 && endsShortSyllable(stem) {
+			w.text += "e"
+		}
+	}
+}
+
+// step1c replaces a final y with i if it's preceded by a non-vowel that isn't
+// the first letter of the word.
+func (w *englishWord) step1c() {
+	n := len(w.text)
+	if n > 2 && (w.text[n-1] == 'y' || w.text[n-1] == 'Y') &&
+		!isEnglishVowel(rune(w.text[n-2])) {
+		w.text = w.text[:n-1] + "i"
+	}
+}
+
+// step2 replaces derivational suffixes in R1.
+func (w *englishWord) step2() {
+	suffix := longestSuffix(w.text, englishStep2Suffixes)
+	if suffix == "" || !w.inR1(suffix) {
+		return
+	}
+
+	stem := strings.TrimSuffix(w.text, suffix)
+	switch suffix {
+	case "ogi":
+		if !strings.HasSuffix(stem, "l") {
+			return
+		}
+	case "li":
+		if stem == "" || strings.IndexByte("cdeghkmnrt", stem[len(stem)-1]) < 0 {
+			return
+		}
+	}
+	w.text = stem + englishStep2[suffix]
+}
+
+// step3 replaces derivational suffixes in R1 (or, for "ative", R2).
+func (w *englishWord) step3() {
+	suffix := longestSuffix(w.text, englishStep3Suffixes)
+	if suffix == "" || !w.inR1(suffix) || (suffix == "ative" && !w.inR2(suffix)) {
+		return
+	}
+	w.text = strings.TrimSuffix(w.text, suffix) + englishStep3[suffix]
+}
+
+// step4 deletes suffixes in R2.
+func (w *englishWord) step4() {
+	suffix := longestSuffix(w.text, englishStep4)
+	if suffix == "" || !w.inR2(suffix) {
+		return
+	}
+
+	stem := strings.TrimSuffix(w.text, suffix)
+	if suffix == "ion" && !strings.HasSuffix(stem, "s") && !strings.HasSuffix(stem, "t") {
+		return
+	}
+	w.text = stem
+}
+
+// step5 deletes a final e (in R2, or in R1 if it doesn't follow a short
+// syllable) or l (in R2, if it follows another l).
+func (w *englishWord) step5() {
+	if strings.HasSuffix(w.text, "e") {
+		stem := strings.TrimSuffix(w.text, "e")
+		if w.inR2("e") || (w.inR1("e") && !endsShortSyllable(stem)) {
+			w.text = stem
+		}
+	} else if strings.HasSuffix(w.text, "ll") && w.inR2("l") {
+		w.text = strings.TrimSuffix(w.text, "l")
+	}
+}
+
+// keys returns the keys of m.
+func keys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
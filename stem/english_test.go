@@ -0,0 +1,22 @@
+package stem
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEnglishVocabulary(t *testing.T) {
+	testVocabulary(t, NewEnglishStemmer(), "snowball_en.txt")
+}
+
+func ExampleEnglishStemmer() {
+	s := NewEnglishStemmer()
+	for _, word := range []string{"run", "runs", "running", "Generously"} {
+		fmt.Println(s.Stem(word))
+	}
+	// Output:
+	// run
+	// run
+	// run
+	// generous
+}
@@ -0,0 +1,341 @@
+package stem
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FrenchStemmer is a port of the Snowball French stemmer (see
+// https://snowballstem.org/algorithms/french/stemmer.html).
+type FrenchStemmer struct {
+}
+
+// NewFrenchStemmer is a FrenchStemmer constructor.
+func NewFrenchStemmer() *FrenchStemmer {
+	return new(FrenchStemmer)
+}
+
+var frenchStep1 = []string{
+	"ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes",
+	"ables", "istes", "atrice", "ateur", "ation", "atrices", "ateurs",
+	"ations", "logie", "logies", "usion", "ution", "usions", "utions", "ence",
+	"ences", "ement", "ements", "ité", "ités", "if", "ive", "ifs", "ives",
+	"eaux", "aux", "euse", "euses", "issement", "issements", "amment",
+	"emment", "ment", "ments"}
+
+var frenchStep2a = []string{
+	"îmes", "ît", "îtes", "i", "ie", "ies", "ir", "ira", "irai", "iraIent",
+	"irais", "irait", "iras", "irent", "irez", "iriez", "irions", "irons",
+	"iront", "is", "issaIent", "issais", "issait", "issant", "issante",
+	"issantes", "issants", "isse", "issent", "isses", "issez", "issiez",
+	"issions", "issons", "it"}
+
+var frenchStep2b = []string{
+	"ions", "é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent",
+	"erais", "erait", "eras", "erez", "eriez", "erions", "erons", "eront",
+	"ez", "iez", "âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait",
+	"ant", "ante", "antes", "ants", "as", "asse", "assent", "asses",
+	"assiez", "assions"}
+
+var frenchStep4 = []string{"ion", "ier", "ière", "Ier", "Ière", "e", "ë"}
+
+var frenchMarkers = strings.NewReplacer("I", "i", "U", "u", "Y", "y")
+
+// A frenchWord is a word being stemmed along with the start of its RV, R1,
+// and R2 regions.
+type frenchWord struct {
+	text       string
+	pv, p1, p2 int
+}
+
+// Stem reduces word, which is converted to lowercase, to its stem.
+func (s FrenchStemmer) Stem(word string) string {
+	w := newFrenchWord(strings.ToLower(word))
+
+	if w.step1() || w.step2a() || w.step2b() {
+		// Step 3
+		if strings.HasSuffix(w.text, "Y") {
+			w.replace("Y", "i")
+		} else if strings.HasSuffix(w.text, "ç") {
+			w.replace("ç", "c")
+		}
+	} else {
+		w.step4()
+	}
+	w.step5()
+	w.step6()
+
+	return frenchMarkers.Replace(w.text)
+}
+
+func isFrenchVowel(r rune) bool {
+	return strings.ContainsRune("aeiouyâàëéêèïîôûù", r)
+}
+
+// newFrenchWord marks the vowels that should be treated as consonants (by
+// converting them to uppercase) and finds the RV, R1, and R2 regions of word.
+func newFrenchWord(word string) *frenchWord {
+	runes := []rune(word)
+	for i := 0; i+1 < len(runes); {
+		r, next := runes[i], runes[i+1]
+		switch {
+		case isFrenchVowel(r) && (next == 'u' || next == 'i') &&
+			i+2 < len(runes) && isFrenchVowel(runes[i+2]):
+			runes[i+1] = next - 'a' + 'A'
+		case isFrenchVowel(r) && next == 'y':
+			runes[i+1] = 'Y'
+		case r == 'y' && isFrenchVowel(next):
+			runes[i] = 'Y'
+		case r == 'q' && next == 'u':
+			runes[i+1] = 'U'
+		default:
+			i++
+		}
+	}
+
+	w := frenchWord{text: string(runes), pv: len(runes)}
+	switch {
+	case len(runes) > 2 && isFrenchVowel(runes[0]) && isFrenchVowel(runes[1]):
+		w.pv = 3
+	case strings.HasPrefix(w.text, "par") || strings.HasPrefix(w.text, "col") ||
+		strings.HasPrefix(w.text, "tap"):
+		w.pv = 3
+	case len(runes) > 0:
+		w.pv = gopast(runes, 1, isFrenchVowel)
+	}
+	w.pv = len(string(runes[:w.pv]))
+
+	w.p1 = region(w.text, 0, isFrenchVowel)
+	w.p2 = region(w.text, w.p1, isFrenchVowel)
+	return &w
+}
+
+// inRV determines if suffix, which w ends with, is in RV.
+func (w *frenchWord) inRV(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.pv
+}
+
+// inR1 determines if suffix, which w ends with, is in R1.
+func (w *frenchWord) inR1(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p1
+}
+
+// inR2 determines if suffix, which w ends with, is in R2.
+func (w *frenchWord) inR2(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p2
+}
+
+// rv returns the RV region of w.
+func (w *frenchWord) rv() string {
+	if w.pv > len(w.text) {
+		return ""
+	}
+	return w.text[w.pv:]
+}
+
+// replace replaces suffix, which w ends with, with s.
+func (w *frenchWord) replace(suffix, s string) {
+	w.text = strings.TrimSuffix(w.text, suffix) + s
+}
+
+// replaceInR2 replaces suffix with s if w ends with it and it's in R2,
+// returning true if it did so.
+func (w *frenchWord) replaceInR2(suffix, s string) bool {
+	if strings.HasSuffix(w.text, suffix) && w.inR2(suffix) {
+		w.replace(suffix, s)
+		return true
+	}
+	return false
+}
+
+// step1 removes a standard suffix, returning true if it did so and Step 2
+// should be skipped.
+func (w *frenchWord) step1() bool {
+	suffix := longestSuffix(w.text, frenchStep1)
+	switch suffix {
+	case "":
+		return false
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes",
+		"ismes", "ables", "istes":
+		return w.replaceInR2(suffix, "")
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if !w.replaceInR2(suffix, "") {
+			return false
+		}
+		if strings.HasSuffix(w.text, "ic") && !w.replaceInR2("ic", "") {
+			w.replace("ic", "iqU")
+		}
+	case "logie", "logies":
+		return w.replaceInR2(suffix, "log")
+	case "usion", "ution", "usions", "utions":
+		return w.replaceInR2(suffix, "u")
+	case "ence", "ences":
+		return w.replaceInR2(suffix, "ent")
+	case "ement", "ements":
+		if !w.inRV(suffix) {
+			return false
+		}
+		w.replace(suffix, "")
+		s := longestSuffix(w.text, []string{"iv", "eus", "abl", "iqU", "ièr", "Ièr"})
+		switch s {
+		case "iv":
+			if w.replaceInR2(s, "") {
+				w.replaceInR2("at", "")
+			}
+		case "eus":
+			if !w.replaceInR2(s, "") && w.inR1(s) {
+				w.replace(s, "eux")
+			}
+		case "abl", "iqU":
+			w.replaceInR2(s, "")
+		case "ièr", "Ièr":
+			if w.inRV(s) {
+				w.replace(s, "i")
+			}
+		}
+	case "ité", "ités":
+		if !w.replaceInR2(suffix, "") {
+			return false
+		}
+		s := longestSuffix(w.text, []string{"abil", "ic", "iv"})
+		switch s {
+		case "abil":
+			if !w.replaceInR2(s, "") {
+				w.replace(s, "abl")
+			}
+		case "ic":
+			if !w.replaceInR2(s, "") {
+				w.replace(s, "iqU")
+			}
+		case "iv":
+			w.replaceInR2(s, "")
+		}
+	case "if", "ive", "ifs", "ives":
+		if !w.replaceInR2(suffix, "") {
+			return false
+		}
+		if w.replaceInR2("at", "") && strings.HasSuffix(w.text, "ic") &&
+			!w.replaceInR2("ic", "") {
+			w.replace("ic", "iqU")
+		}
+	case "eaux":
+		w.replace(suffix, "eau")
+	case "aux":
+		if !w.inR1(suffix) {
+			return false
+		}
+		w.replace(suffix, "al")
+	case "euse", "euses":
+		if !w.replaceInR2(suffix, "") {
+			if !w.inR1(suffix) {
+				return false
+			}
+			w.replace(suffix, "eux")
+		}
+	case "issement", "issements":
+		stem := strings.TrimSuffix(w.text, suffix)
+		if !w.inR1(suffix) || stem == "" || isFrenchVowel(lastRune(stem)) {
+			return false
+		}
+		w.text = stem
+	case "amment":
+		if w.inRV(suffix) {
+			w.replace(suffix, "ant")
+		}
+		return false
+	case "emment":
+		if w.inRV(suffix) {
+			w.replace(suffix, "ent")
+		}
+		return false
+	case "ment", "ments":
+		stem := strings.TrimSuffix(w.text, suffix)
+		r := lastRune(stem)
+		if stem != "" && isFrenchVowel(r) && len(stem)-utf8.RuneLen(r) >= w.pv {
+			w.text = stem
+		}
+		return false
+	}
+	return true
+}
+
+// step2a removes a verb suffix beginning with i (in RV and preceded by a
+// non-vowel that's also in RV), returning true if it did so.
+func (w *frenchWord) step2a() bool {
+	rv := w.rv()
+	suffix := longestSuffix(rv, frenchStep2a)
+	stem := strings.TrimSuffix(rv, suffix)
+	if suffix == "" || stem == "" || isFrenchVowel(lastRune(stem)) {
+		return false
+	}
+	w.replace(suffix, "")
+	return true
+}
+
+// step2b removes any other verb suffix in RV, returning true if it did so.
+func (w *frenchWord) step2b() bool {
+	suffix := longestSuffix(w.rv(), frenchStep2b)
+	switch suffix {
+	case "":
+		return false
+	case "ions":
+		return w.replaceInR2(suffix, "")
+	case "é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent",
+		"erais", "erait", "eras", "erez", "eriez", "erions", "erons", "eront",
+		"ez", "iez":
+		w.replace(suffix, "")
+	default:
+		w.replace(suffix, "")
+		if strings.HasSuffix(w.rv(), "e") {
+			w.replace("e", "")
+		}
+	}
+	return true
+}
+
+// step4 removes a residual suffix.
+func (w *frenchWord) step4() {
+	if stem := strings.TrimSuffix(w.text, "s"); stem != w.text && stem != "" &&
+		!strings.ContainsRune("aiouès", lastRune(stem)) {
+		w.text = stem
+	}
+	if w.pv > len(w.text) {
+		return
+	}
+
+	rv := w.rv()
+	switch suffix := longestSuffix(rv, frenchStep4); suffix {
+	case "ion":
+		stem := strings.TrimSuffix(rv, suffix)
+		if w.inR2(suffix) && (strings.HasSuffix(stem, "s") || strings.HasSuffix(stem, "t")) {
+			w.replace(suffix, "")
+		}
+	case "ier", "ière", "Ier", "Ière":
+		w.replace(suffix, "i")
+	case "e":
+		w.replace(suffix, "")
+	case "ë":
+		if strings.HasSuffix(rv, "guë") {
+			w.replace(suffix, "")
+		}
+	}
+}
+
+// step5 undoubles a final consonant.
+func (w *frenchWord) step5() {
+	if longestSuffix(w.text, []string{"enn", "onn", "ett", "ell", "eill"}) != "" {
+		w.text = w.text[:len(w.text)-1]
+	}
+}
+
+// step6 removes the accent from an é or è that's followed only by (at least
+// one) non-vowels.
+func (w *frenchWord) step6() {
+	end := strings.LastIndexFunc(w.text, isFrenchVowel)
+	if end < 0 || end == len(w.text)-utf8.RuneLen(lastRune(w.text)) {
+		return
+	}
+	if r, size := utf8.DecodeRuneInString(w.text[end:]); r == 'é' || r == 'è' {
+		w.text = w.text[:end] + "e" + w.text[end+size:]
+	}
+}
@@ -0,0 +1,21 @@
+package stem
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFrenchVocabulary(t *testing.T) {
+	testVocabulary(t, NewFrenchStemmer(), "snowball_fr.txt")
+}
+
+func ExampleFrenchStemmer() {
+	s := NewFrenchStemmer()
+	for _, word := range []string{"continuer", "continuellement", "Continuation"} {
+		fmt.Println(s.Stem(word))
+	}
+	// Output:
+	// continu
+	// continuel
+	// continu
+}
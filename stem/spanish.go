@@ -0,0 +1,243 @@
+package stem
+
+import (
+	"strings"
+)
+
+// SpanishStemmer is a port of the Snowball Spanish stemmer (see
+// https://snowballstem.org/algorithms/spanish/stemmer.html).
+type SpanishStemmer struct {
+}
+
+// NewSpanishStemmer is a SpanishStemmer constructor.
+func NewSpanishStemmer() *SpanishStemmer {
+	return new(SpanishStemmer)
+}
+
+// spanishPronouns are the pronouns that may be attached to a verb.
+var spanishPronouns = []string{
+	"me", "se", "sela", "selo", "selas", "selos", "la", "le", "lo", "las",
+	"les", "los", "nos"}
+
+// spanishPronounHosts are the verb endings that an attached pronoun may
+// follow, along with what they're replaced by once the pronoun is removed.
+var spanishPronounHosts = map[string]string{
+	"iéndo": "iendo", "ándo": "ando", "ár": "ar", "ér": "er", "ír": "ir",
+	"ando": "ando", "iendo": "iendo", "ar": "ar", "er": "er", "ir": "ir",
+	"yendo": "yendo"}
+
+var spanishPronounHostSuffixes = keys(spanishPronounHosts)
+
+var spanishStep1 = []string{
+	"anza", "anzas", "ico", "ica", "icos", "icas", "ismo", "ismos", "able",
+	"ables", "ible", "ibles", "ista", "istas", "oso", "osa", "osos", "osas",
+	"amiento", "amientos", "imiento", "imientos", "adora", "ador", "ación",
+	"adoras", "adores", "aciones", "ante", "antes", "ancia", "ancias",
+	"logía", "logías", "ución", "uciones", "encia", "encias", "amente",
+	"mente", "idad", "idades", "iva", "ivo", "ivas", "ivos"}
+
+var spanishStep2a = []string{
+	"ya", "ye", "yan", "yen", "yeron", "yendo", "yo", "yó", "yas", "yes",
+	"yais", "yamos"}
+
+var spanishStep2b = []string{
+	"en", "es", "éis", "emos", "arían", "arías", "arán", "arás", "aríais",
+	"aría", "aréis", "aríamos", "aremos", "ará", "aré", "erían", "erías",
+	"erán", "erás", "eríais", "ería", "eréis", "eríamos", "eremos", "erá",
+	"eré", "irían", "irías", "irán", "irás", "iríais", "iría", "iréis",
+	"iríamos", "iremos", "irá", "iré", "aba", "ada", "ida", "ía", "ara",
+	"iera", "ad", "ed", "id", "ase", "iese", "aste", "iste", "an", "aban",
+	"ían", "aran", "ieran", "asen", "iesen", "aron", "ieron", "ado", "ido",
+	"ando", "iendo", "ió", "ar", "er", "ir", "as", "abas", "adas", "idas",
+	"ías", "aras", "ieras", "ases", "ieses", "ís", "áis", "abais", "íais",
+	"arais", "ierais", "aseis", "ieseis", "asteis", "isteis", "ados", "idos",
+	"amos", "ábamos", "íamos", "imos", "áramos", "iéramos", "iésemos",
+	"ásemos"}
+
+var spanishStep3 = []string{"os", "a", "o", "á", "í", "ó", "e", "é"}
+
+var spanishAccents = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u")
+
+// A spanishWord is a word being stemmed along with the start of its RV, R1,
+// and R2 regions.
+type spanishWord struct {
+	text       string
+	pv, p1, p2 int
+}
+
+// Stem reduces word, which is converted to lowercase, to its stem.
+func (s SpanishStemmer) Stem(word string) string {
+	w := newSpanishWord(strings.ToLower(word))
+	w.step0()
+	if !w.step1() && !w.step2a() {
+		w.step2b()
+	}
+	w.step3()
+	return spanishAccents.Replace(w.text)
+}
+
+func isSpanishVowel(r rune) bool {
+	return strings.ContainsRune("aeiouáéíóúü", r)
+}
+
+// newSpanishWord finds the RV, R1, and R2 regions of word.
+//
+// If the second letter of word is a consonant, RV is the region after the
+// next vowel; if the first two letters are vowels, it's the region after the
+// next consonant; otherwise, it's the region after the third letter.
+func newSpanishWord(word string) *spanishWord {
+	w := spanishWord{text: word, pv: len(word)}
+
+	runes := []rune(word)
+	if len(runes) >= 2 {
+		i := len(runes)
+		switch {
+		case !isSpanishVowel(runes[1]):
+			i = gopast(runes, 2, isSpanishVowel)
+		case isSpanishVowel(runes[0]):
+			i = gopast(runes, 2, func(r rune) bool { return !isSpanishVowel(r) })
+		case len(runes) > 2:
+			i = 3
+		}
+		w.pv = len(string(runes[:i]))
+	}
+
+	w.p1 = region(word, 0, isSpanishVowel)
+	w.p2 = region(word, w.p1, isSpanishVowel)
+	return &w
+}
+
+// inRV determines if suffix, which w ends with, is in RV.
+func (w *spanishWord) inRV(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.pv
+}
+
+// inR1 determines if suffix, which w ends with, is in R1.
+func (w *spanishWord) inR1(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p1
+}
+
+// inR2 determines if suffix, which w ends with, is in R2.
+func (w *spanishWord) inR2(suffix string) bool {
+	return len(w.text)-len(suffix) >= w.p2
+}
+
+// rv returns the RV region of w.
+func (w *spanishWord) rv() string {
+	if w.pv > len(w.text) {
+		return ""
+	}
+	return w.text[w.pv:]
+}
+
+// step0 removes an attached pronoun from a gerund or infinitive.
+func (w *spanishWord) step0() {
+	pronoun := longestSuffix(w.text, spanishPronouns)
+	if pronoun == "" {
+		return
+	}
+
+	stem := strings.TrimSuffix(w.text, pronoun)
+	host := longestSuffix(stem, spanishPronounHostSuffixes)
+	if host == "" || len(stem)-len(host) < w.pv {
+		return
+	} else if host == "yendo" && !strings.HasSuffix(stem, "uyendo") {
+		return
+	}
+	w.text = strings.TrimSuffix(stem, host) + spanishPronounHosts[host]
+}
+
+// step1 removes a standard suffix, returning true if it did so.
+func (w *spanishWord) step1() bool {
+	suffix := longestSuffix(w.text, spanishStep1)
+	if suffix == "" {
+		return false
+	}
+
+	stem := strings.TrimSuffix(w.text, suffix)
+	switch suffix {
+	case "amente":
+		if !w.inR1(suffix) {
+			return false
+		}
+		w.text = stem
+		s := longestSuffix(w.text, []string{"iv", "os", "ic", "ad"})
+		if s != "" && w.inR2(s) {
+			w.text = strings.TrimSuffix(w.text, s)
+			if s == "iv" {
+				w.deleteInR2("at")
+			}
+		}
+		return true
+	case "logía", "logías":
+		stem += "log"
+	case "ución", "uciones":
+		stem += "u"
+	case "encia", "encias":
+		stem += "ente"
+	}
+	if !w.inR2(suffix) {
+		return false
+	}
+	w.text = stem
+
+	switch suffix {
+	case "adora", "ador", "ación", "adoras", "adores", "aciones", "ante",
+		"antes", "ancia", "ancias":
+		w.deleteInR2("ic")
+	case "mente":
+		w.deleteInR2(longestSuffix(w.text, []string{"ante", "able", "ible"}))
+	case "idad", "idades":
+		w.deleteInR2(longestSuffix(w.text, []string{"abil", "ic", "iv"}))
+	case "iva", "ivo", "ivas", "ivos":
+		w.deleteInR2("at")
+	}
+	return true
+}
+
+// deleteInR2 removes suffix if w ends with it and it's in R2.
+func (w *spanishWord) deleteInR2(suffix string) {
+	if suffix != "" && strings.HasSuffix(w.text, suffix) && w.inR2(suffix) {
+		w.text = strings.TrimSuffix(w.text, suffix)
+	}
+}
+
+// step2a removes a verb suffix beginning with y (in RV and preceded by u),
+// returning true if it did so.
+func (w *spanishWord) step2a() bool {
+	suffix := longestSuffix(w.rv(), spanishStep2a)
+	if suffix == "" || !strings.HasSuffix(w.text, "u"+suffix) {
+		return false
+	}
+	w.text = strings.TrimSuffix(w.text, suffix)
+	return true
+}
+
+// step2b removes any other verb suffix in RV.
+func (w *spanishWord) step2b() {
+	suffix := longestSuffix(w.rv(), spanishStep2b)
+	if suffix == "" {
+		return
+	}
+	w.text = strings.TrimSuffix(w.text, suffix)
+	switch suffix {
+	case "en", "es", "éis", "emos":
+		if strings.HasSuffix(w.text, "gu") {
+			w.text = strings.TrimSuffix(w.text, "u")
+		}
+	}
+}
+
+// step3 removes a residual suffix in RV.
+func (w *spanishWord) step3() {
+	suffix := longestSuffix(w.text, spanishStep3)
+	if suffix == "" || !w.inRV(suffix) {
+		return
+	}
+	w.text = strings.TrimSuffix(w.text, suffix)
+	if (suffix == "e" || suffix == "é") && strings.HasSuffix(w.text, "gu") &&
+		w.inRV("u") {
+		w.text = strings.TrimSuffix(w.text, "u")
+	}
+}
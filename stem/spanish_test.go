@@ -0,0 +1,21 @@
+package stem
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSpanishVocabulary(t *testing.T) {
+	testVocabulary(t, NewSpanishStemmer(), "snowball_es.txt")
+}
+
+func ExampleSpanishStemmer() {
+	s := NewSpanishStemmer()
+	for _, word := range []string{"corre", "corriendo", "Corrieron"} {
+		fmt.Println(s.Stem(word))
+	}
+	// Output:
+	// corr
+	// corr
+	// corr
+}
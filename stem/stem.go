@@ -0,0 +1,83 @@
+/*
+Package stem implements functions for reducing words to their stems (e.g.,
+"running" -> "run").
+
+The stemmers are ports of the Snowball algorithms (see
+https://snowballstem.org/algorithms/) for English (also known as Porter2),
+Spanish, and French.
+*/
+package stem
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// Stemmer is the interface implemented by an object that reduces a word to
+// its stem.
+type Stemmer interface {
+	Stem(word string) string
+}
+
+// NewStemmer creates a new Stemmer for the specified language. If the given
+// language is not supported, an error will be returned.
+//
+// Languages are specified by their two-character ISO 639-1 code. The supported
+// languages are "en" (English), "es" (Spanish), and "fr" (French).
+func NewStemmer(lang string) (Stemmer, error) {
+	switch lang {
+	case "en":
+		return NewEnglishStemmer(), nil
+	case "es":
+		return NewSpanishStemmer(), nil
+	case "fr":
+		return NewFrenchStemmer(), nil
+	}
+	return nil, errors.New("unknown language")
+}
+
+// region returns the byte offset of the region that follows the first
+// non-vowel that follows a vowel in word[start:] (or len(word) if there isn't
+// one). This is how the Snowball algorithms define R1 and R2.
+func region(word string, start int, isVowel func(rune) bool) int {
+	seen := false
+	for i, r := range word[start:] {
+		if isVowel(r) {
+			seen = true
+		} else if seen {
+			return start + i + utf8.RuneLen(r)
+		}
+	}
+	return len(word)
+}
+
+// gopast returns the index of the character after the first one in
+// word[start:] that satisfies f (or len(word) if there isn't one).
+func gopast(word []rune, start int, f func(rune) bool) int {
+	for i := start; i < len(word); i++ {
+		if f(word[i]) {
+			return i + 1
+		}
+	}
+	return len(word)
+}
+
+// longestSuffix returns the longest of suffixes that word ends with (or "" if
+// it doesn't end with any of them).
+func longestSuffix(word string, suffixes []string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && strings.HasSuffix(word, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+// lastRune returns the last character of word (or utf8.RuneError if word is
+// empty).
+func lastRune(word string) rune {
+	r, _ := utf8.DecodeLastRuneInString(word)
+	return r
+}
@@ -0,0 +1,35 @@
+package stem
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var testdata = filepath.Join("..", "testdata")
+
+// testVocabulary stems every word in the given Snowball vocabulary (see
+// https://github.com/snowballstem/snowball-data), which has one "word stem"
+// pair per line.
+func testVocabulary(t *testing.T, s Stemmer, name string) {
+	data := string(util.ReadDataFile(filepath.Join(testdata, name)))
+	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
+		pair := strings.Fields(line)
+		if stem := s.Stem(pair[0]); stem != pair[1] {
+			t.Errorf("Stem(%q) = %q, want %q", pair[0], stem, pair[1])
+		}
+	}
+}
+
+func TestNewStemmer(t *testing.T) {
+	for _, lang := range []string{"en", "es", "fr"} {
+		s, err := NewStemmer(lang)
+		assert.Nil(t, err)
+		assert.NotNil(t, s)
+	}
+	_, err := NewStemmer("xx")
+	assert.NotNil(t, err)
+}
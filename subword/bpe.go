@@ -0,0 +1,229 @@
+package subword
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/jdkato/prose/tokenize"
+)
+
+// endOfWord is appended to the last symbol of each word, which allows a BPE
+// model to distinguish between suffixes (e.g., "er</w>") and other subwords.
+const endOfWord = "</w>"
+
+// BPE splits words into subwords using byte-pair encoding: starting from its
+// characters, each word is built up by repeatedly merging the adjacent pair of
+// subwords that was the most frequent in the training corpus.
+//
+// This implementation follows Sennrich et al. (2016; see
+// https://github.com/rsennrich/subword-nmt), marking the end of each word
+// with "</w>".
+type BPE struct {
+	Words   tokenize.SpanTokenizer // splits text into words
+	Unknown string                 // replaces characters missing from the vocabulary
+
+	vocab  *vocabulary
+	merges [][2]string
+	ranks  map[[2]string]int
+}
+
+func newBPE(vocab *vocabulary, merges [][2]string) *BPE {
+	b := BPE{
+		Words: newWordTokenizer(), Unknown: "<unk>", vocab: vocab,
+		merges: merges, ranks: make(map[[2]string]int)}
+	for i, pair := range merges {
+		if _, found := b.ranks[pair]; !found {
+			b.ranks[pair] = i
+		}
+	}
+	return &b
+}
+
+// NewBPE creates a new BPE model from a vocabulary (one subword per line,
+// whose line number is its ID) read from vocab and a list of merges (one pair
+// of space-separated subwords per line, in order of priority) read from
+// merges.
+func NewBPE(vocab, merges io.Reader) (*BPE, error) {
+	v, err := readVocabulary(vocab)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := [][2]string{}
+	scanner := bufio.NewScanner(merges)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#version") {
+			continue
+		}
+		fields := strings.Split(line, " ")
+		if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+			return nil, fmt.Errorf("merges.txt:%d: invalid merge %q", n, line)
+		}
+		pairs = append(pairs, [2]string{fields[0], fields[1]})
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return newBPE(v, pairs), nil
+}
+
+// BPE learns a BPE model with a vocabulary of size subwords from the Trainer's
+// corpus.
+//
+// The vocabulary starts with Unknown ("<unk>" and "<unk></w>") and every
+// character in the corpus; merges are then learned until the vocabulary is
+// full or no pair of subwords occurs more than once. So, the vocabulary may
+// be smaller than size or--if the corpus has more than size-2 distinct
+// characters, which are never left out--larger.
+func (t *Trainer) BPE(size int) *BPE {
+	m := newMerger(t.counts, func(word string) []string {
+		symbols := []string{}
+		for _, sym := range splitBPE(word) {
+			symbols = append(symbols, sym.text)
+		}
+		return symbols
+	})
+
+	vocab := newVocabulary()
+	vocab.add("<unk>")
+	vocab.add("<unk>" + endOfWord)
+	for _, sym := range m.symbols() {
+		vocab.add(sym)
+	}
+
+	merges := [][2]string{}
+	for len(vocab.tokens) < size {
+		pair, found := m.best(func(count, a, b int) float64 {
+			return float64(count)
+		})
+		if !found {
+			break
+		}
+		m.merge(pair, pair[0]+pair[1])
+		merges = append(merges, pair)
+		vocab.add(pair[0] + pair[1])
+	}
+
+	b := newBPE(vocab, merges)
+	b.Words = t.Words
+	return b
+}
+
+// Save writes the model's vocabulary to vocab and its merges to merges, in
+// the format read by NewBPE.
+func (b BPE) Save(vocab, merges io.Writer) error {
+	if err := b.vocab.write(vocab); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(merges)
+	if _, err := bw.WriteString("#version: 0.2\n"); err != nil {
+		return err
+	}
+	for _, pair := range b.merges {
+		if _, err := bw.WriteString(pair[0] + " " + pair[1] + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Tokenize splits text into a slice of subwords.
+func (b BPE) Tokenize(text string) []string {
+	tokens := []string{}
+	for _, tok := range b.Encode(text) {
+		tokens = append(tokens, tok.Text)
+	}
+	return tokens
+}
+
+// Encode splits text into subwords, returning each one's ID and location.
+//
+// A character that doesn't belong to any subword in the vocabulary is
+// replaced by Unknown (whose ID is -1 if it isn't in the vocabulary either)--
+// or, at the end of a word, by Unknown followed by "</w>", so that Decode
+// can still tell where the word ends. If the vocabulary has no entry for the
+// latter (trained models always do), Unknown's ID is used instead.
+func (b BPE) Encode(text string) []Token {
+	tokens := encodeWords(text, b.Words, b.vocab, b.split)
+	for i, tok := range tokens {
+		if tok.ID < 0 && tok.Text == b.Unknown+endOfWord {
+			tokens[i].ID = b.vocab.id(b.Unknown)
+		}
+	}
+	return tokens
+}
+
+// Decode converts a slice of IDs into text, separating words with a single
+// space. IDs that aren't in the vocabulary are ignored.
+func (b BPE) Decode(ids []int) string {
+	var buf bytes.Buffer
+	for _, id := range ids {
+		if token, found := b.vocab.token(id); found {
+			if strings.HasSuffix(token, endOfWord) {
+				buf.WriteString(strings.TrimSuffix(token, endOfWord) + " ")
+			} else {
+				buf.WriteString(token)
+			}
+		}
+	}
+	return strings.TrimSuffix(buf.String(), " ")
+}
+
+// split applies the model's merges, in order of priority, to word.
+func (b BPE) split(word string) []symbol {
+	symbols := splitBPE(word)
+	for len(symbols) > 1 {
+		best, rank := -1, len(b.merges)
+		for i := 1; i < len(symbols); i++ {
+			r, found := b.ranks[[2]string{symbols[i-1].text, symbols[i].text}]
+			if found && r < rank {
+				best, rank = i, r
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		pair := b.merges[rank]
+		merged := symbols[:0]
+		for i := 0; i < len(symbols); i++ {
+			if i+1 < len(symbols) && symbols[i].text == pair[0] &&
+				symbols[i+1].text == pair[1] {
+				merged = append(merged, symbol{
+					text: pair[0] + pair[1], start: symbols[i].start,
+					end: symbols[i+1].end})
+				i++
+			} else {
+				merged = append(merged, symbols[i])
+			}
+		}
+		symbols = merged
+	}
+
+	for i, sym := range symbols {
+		if b.vocab.id(sym.text) >= 0 {
+			continue
+		} else if strings.HasSuffix(sym.text, endOfWord) {
+			symbols[i].text = b.Unknown + endOfWord
+		} else {
+			symbols[i].text = b.Unknown
+		}
+	}
+	return symbols
+}
+
+// splitBPE splits word into its characters, marking the last one with
+// endOfWord.
+func splitBPE(word string) []symbol {
+	symbols := splitRunes(word)
+	if len(symbols) > 0 {
+		symbols[len(symbols)-1].text += endOfWord
+	}
+	return symbols
+}
@@ -0,0 +1,126 @@
+package subword
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/jdkato/prose/tokenize"
+	"github.com/stretchr/testify/assert"
+)
+
+var testdata = filepath.Join("..", "testdata")
+
+func getSherlock() string {
+	return string(util.ReadDataFile(filepath.Join(testdata, "sherlock.txt")))
+}
+
+func ExampleNewBPE() {
+	vocab := strings.NewReader(strings.Join([]string{
+		"<unk>", "e", "l", "o", "r", "s", "w", "r</w>", "t</w>", "es", "est</w>",
+		"lo", "low", "er</w>"}, "\n"))
+	merges := strings.NewReader("#version: 0.2\ne s\nes t</w>\nl o\nlo w\ne r</w>\n")
+
+	model, err := NewBPE(vocab, merges)
+	if err != nil {
+		panic(err)
+	}
+	for _, tok := range model.Encode("lowest lower") {
+		fmt.Println(tok.Text, tok.ID, tok.Start, tok.End)
+	}
+	// Output:
+	// low 12 0 3
+	// est</w> 10 3 6
+	// low 12 7 10
+	// er</w> 13 10 12
+}
+
+func TestBPE(t *testing.T) {
+	text := getSherlock()
+	trainer := NewTrainer()
+	trainer.Train(text)
+
+	model := trainer.BPE(1000)
+	assert.Equal(t, 1000, len(model.vocab.tokens))
+
+	var vocab, merges bytes.Buffer
+	util.CheckError(model.Save(&vocab, &merges))
+	loaded, err := NewBPE(&vocab, &merges)
+	util.CheckError(err)
+
+	tokens := model.Encode(text)
+	assert.Equal(t, tokens, loaded.Encode(text))
+
+	ids := []int{}
+	runes := []rune(text)
+	for _, tok := range tokens {
+		source := text[tok.Start:tok.End]
+		if tok.ID < 0 || strings.TrimSuffix(tok.Text, endOfWord) != source ||
+			string(runes[tok.RuneStart:tok.RuneEnd]) != source {
+			t.Fatalf("bad token: %+v", tok)
+		}
+		ids = append(ids, tok.ID)
+	}
+
+	words := tokenize.MustNewRegexpTokenizer(`\S+`, false, false)
+	assert.Equal(t,
+		strings.Join(model.Words.Tokenize(text), " "),
+		strings.Join(words.Tokenize(model.Decode(ids)), " "))
+}
+
+func TestBPEUnknown(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.Train("abc abc abd")
+
+	model := trainer.BPE(100)
+	assert.Equal(t, []string{"abc</w>", "ab", "<unk>", "<unk></w>"}, model.Tokenize("abc abxy"))
+	assert.Equal(t, 1, model.Encode("x")[0].ID)
+
+	// The end of an unknown word is kept, so it isn't joined to the next one.
+	ids := []int{}
+	for _, tok := range model.Encode("abx abc") {
+		ids = append(ids, tok.ID)
+	}
+	assert.Equal(t, "ab<unk> abc", model.Decode(ids))
+
+	// Without an entry for the end of an unknown word, Unknown's ID is used.
+	loaded, err := NewBPE(strings.NewReader("<unk>\na\nb</w>"), strings.NewReader(""))
+	util.CheckError(err)
+	assert.Equal(t, []int{1, 0}, []int{loaded.Encode("ax")[0].ID, loaded.Encode("ax")[1].ID})
+}
+
+func TestBPESmallVocabulary(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.Train("abc abc abd xyz")
+
+	// Every character is kept, even though that exceeds the requested size.
+	model := trainer.BPE(3)
+	assert.Equal(t, trainer.BPE(0).vocab.tokens, model.vocab.tokens)
+	assert.True(t, len(model.vocab.tokens) > 3)
+	assert.Empty(t, model.merges)
+	for _, tok := range model.Encode("abc abd xyz") {
+		assert.NotEqual(t, 0, tok.ID)
+	}
+}
+
+func TestNewBPEErrors(t *testing.T) {
+	_, err := NewBPE(strings.NewReader("a\nb\na\n"), strings.NewReader(""))
+	assert.EqualError(t, err, `vocab.txt:3: duplicate token "a"`)
+
+	_, err = NewBPE(strings.NewReader("a\nb\n"), strings.NewReader("a b\nab\n"))
+	assert.EqualError(t, err, `merges.txt:2: invalid merge "ab"`)
+}
+
+func BenchmarkBPE(b *testing.B) {
+	text := getSherlock()
+	trainer := NewTrainer()
+	trainer.Train(text)
+	model := trainer.BPE(1000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		model.Encode(text)
+	}
+}
@@ -0,0 +1,280 @@
+/*
+Package subword implements tokenizers that split words into smaller, reusable
+units (e.g., "tokenization" -> "token", "ization"), as used by many neural
+language models.
+
+Two algorithms are supported: byte-pair encoding (BPE), as described in
+Sennrich et al. (2016), and WordPiece, as used by BERT. Models can either be
+learned from a corpus (see Trainer) or loaded from the vocab.txt and
+merges.txt files distributed with pre-trained models.
+*/
+package subword
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jdkato/prose/tokenize"
+)
+
+// A Token is a subword along with its ID in the vocabulary of the model that
+// produced it.
+//
+// Start and End (and RuneStart and RuneEnd) refer to the part of the source
+// text that the subword was taken from, which doesn't include any markers
+// (e.g., "</w>" or "##") in its Text.
+type Token struct {
+	tokenize.Span
+	ID int // the subword's position in the vocabulary
+}
+
+// newWordTokenizer returns the tokenizer used to split text into words before
+// they're split into subwords: runs of letters, marks, and numbers, or runs of
+// anything else other than whitespace.
+func newWordTokenizer() tokenize.SpanTokenizer {
+	return tokenize.MustNewRegexpTokenizer(
+		`[\p{L}\p{M}\p{N}]+|[^\p{L}\p{M}\p{N}\s]+`, false, false)
+}
+
+// A Trainer learns a BPE or WordPiece model from a corpus.
+type Trainer struct {
+	Words tokenize.SpanTokenizer // splits text into words
+
+	counts map[string]int
+}
+
+// NewTrainer creates a new Trainer that hasn't seen any text.
+func NewTrainer() *Trainer {
+	return &Trainer{Words: newWordTokenizer(), counts: make(map[string]int)}
+}
+
+// Train adds the words in text to the Trainer's corpus. It may be called any
+// number of times before creating a model.
+func (t *Trainer) Train(text string) {
+	for _, word := range t.Words.Tokenize(text) {
+		t.counts[word]++
+	}
+}
+
+// A vocabulary assigns an ID to each of a model's tokens.
+type vocabulary struct {
+	ids    map[string]int
+	tokens []string
+}
+
+func newVocabulary() *vocabulary {
+	return &vocabulary{ids: make(map[string]int)}
+}
+
+// add appends token to the vocabulary, unless it's already present.
+func (v *vocabulary) add(token string) {
+	if _, found := v.ids[token]; !found {
+		v.ids[token] = len(v.tokens)
+		v.tokens = append(v.tokens, token)
+	}
+}
+
+// id returns the ID of token (or -1 if it isn't in the vocabulary).
+func (v *vocabulary) id(token string) int {
+	if id, found := v.ids[token]; found {
+		return id
+	}
+	return -1
+}
+
+// token returns the token with the given ID (and whether or not it exists).
+func (v *vocabulary) token(id int) (string, bool) {
+	if id < 0 || id >= len(v.tokens) {
+		return "", false
+	}
+	return v.tokens[id], true
+}
+
+// readVocabulary reads a vocab.txt file: one token per line, whose line number
+// (starting from 0) is its ID.
+func readVocabulary(r io.Reader) (*vocabulary, error) {
+	v := newVocabulary()
+	scanner := bufio.NewScanner(r)
+	for n := 0; scanner.Scan(); n++ {
+		token := strings.TrimSuffix(scanner.Text(), "\r")
+		if _, found := v.ids[token]; found {
+			return nil, fmt.Errorf("vocab.txt:%d: duplicate token %q", n+1, token)
+		}
+		v.add(token)
+	}
+	return v, scanner.Err()
+}
+
+// write writes the vocabulary in the format read by readVocabulary.
+func (v *vocabulary) write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, token := range v.tokens {
+		if _, err := bw.WriteString(token + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// A symbol is a part of a word along with its location in the word.
+type symbol struct {
+	text       string
+	start, end int
+}
+
+// encodeWords splits text into words and each word into subwords using split,
+// converting the offsets of each subword so that they're relative to text.
+func encodeWords(
+	text string, words tokenize.SpanTokenizer, vocab *vocabulary,
+	split func(word string) []symbol) []Token {
+	tokens := []Token{}
+	for _, word := range words.TokenizeSpans(text) {
+		// The offsets of a symbol can only be trusted if the word is a
+		// substring of text.
+		exact := word.End-word.Start == len(word.Text)
+		for _, sym := range split(word.Text) {
+			tok := Token{Span: word, ID: vocab.id(sym.text)}
+			tok.Text = sym.text
+			if exact {
+				tok.Start = word.Start + sym.start
+				tok.End = word.Start + sym.end
+				tok.RuneStart = word.RuneStart + utf8.RuneCountInString(
+					word.Text[:sym.start])
+				tok.RuneEnd = tok.RuneStart + utf8.RuneCountInString(
+					word.Text[sym.start:sym.end])
+			}
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// splitRunes splits word into its characters.
+func splitRunes(word string) []symbol {
+	symbols := []symbol{}
+	for i := 0; i < len(word); {
+		_, size := utf8.DecodeRuneInString(word[i:])
+		symbols = append(symbols, symbol{
+			text: word[i : i+size], start: i, end: i + size})
+		i += size
+	}
+	return symbols
+}
+
+// minPairCount is the number of times a pair of symbols needs to occur in the
+// corpus to be merged.
+const minPairCount = 2
+
+// A merger repeatedly merges the best pair of adjacent symbols in a set of
+// words.
+type merger struct {
+	words  [][]string
+	counts []int
+	pairs  map[[2]string]int // the number of times each pair occurs
+	freqs  map[string]int    // the number of times each symbol occurs
+}
+
+// newMerger splits each word in counts into its initial symbols.
+func newMerger(counts map[string]int, split func(word string) []string) *merger {
+	// Sort the words so that training is deterministic.
+	words := []string{}
+	for word := range counts {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	m := merger{pairs: make(map[[2]string]int), freqs: make(map[string]int)}
+	for i, word := range words {
+		m.words = append(m.words, split(word))
+		m.counts = append(m.counts, counts[word])
+		m.count(i, 1)
+	}
+	return &m
+}
+
+// count adds (or, if sign is -1, removes) the pairs and symbols of the ith
+// word to the merger's totals.
+func (m *merger) count(i, sign int) {
+	word, n := m.words[i], sign*m.counts[i]
+	for j, sym := range word {
+		m.freqs[sym] += n
+		if j > 0 {
+			pair := [2]string{word[j-1], sym}
+			if m.pairs[pair] += n; m.pairs[pair] == 0 {
+				delete(m.pairs, pair)
+			}
+		}
+	}
+}
+
+// symbols returns the initial (or current) symbols in sorted order.
+func (m *merger) symbols() []string {
+	symbols := []string{}
+	for sym, n := range m.freqs {
+		if n > 0 {
+			symbols = append(symbols, sym)
+		}
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// best finds the pair of adjacent symbols with the highest score, breaking
+// ties lexicographically. Score is given the number of times the pair occurs
+// and the number of times each of its symbols occurs.
+func (m *merger) best(score func(pair, a, b int) float64) ([2]string, bool) {
+	var best [2]string
+	found, max := false, 0.0
+	for pair, count := range m.pairs {
+		if count < minPairCount {
+			continue
+		}
+		s := score(count, m.freqs[pair[0]], m.freqs[pair[1]])
+		if !found || s > max || (s == max && (pair[0] < best[0] ||
+			pair[0] == best[0] && pair[1] < best[1])) {
+			best, max, found = pair, s, true
+		}
+	}
+	return best, found
+}
+
+// merge replaces every occurrence of pair with merged.
+func (m *merger) merge(pair [2]string, merged string) {
+	for i, word := range m.words {
+		if !containsPair(word, pair) {
+			continue
+		}
+		m.count(i, -1)
+		m.words[i] = mergeSymbols(word, pair, merged)
+		m.count(i, 1)
+	}
+}
+
+// containsPair determines if pair occurs in word.
+func containsPair(word []string, pair [2]string) bool {
+	for j := 1; j < len(word); j++ {
+		if word[j-1] == pair[0] && word[j] == pair[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeSymbols replaces every occurrence of pair in word (from left to right)
+// with merged.
+func mergeSymbols(word []string, pair [2]string, merged string) []string {
+	out := word[:0]
+	for j := 0; j < len(word); j++ {
+		if j+1 < len(word) && word[j] == pair[0] && word[j+1] == pair[1] {
+			out = append(out, merged)
+			j++
+		} else {
+			out = append(out, word[j])
+		}
+	}
+	return out
+}
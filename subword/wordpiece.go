@@ -0,0 +1,170 @@
+package subword
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jdkato/prose/tokenize"
+)
+
+// specialTokens are added to the start of every vocabulary learned for a
+// WordPiece model, matching the layout of BERT's vocab.txt files.
+var specialTokens = []string{"[PAD]", "[UNK]", "[CLS]", "[SEP]", "[MASK]"}
+
+// WordPiece splits words into subwords by repeatedly taking the longest
+// prefix of the rest of the word that's in its vocabulary.
+//
+// Subwords that continue a word are marked with Prefix (e.g., "playing" ->
+// "play", "##ing"). A word that can't be split into subwords from the
+// vocabulary is replaced by Unknown.
+//
+// This implementation follows the one used by BERT (see
+// https://github.com/google-research/bert).
+type WordPiece struct {
+	Words    tokenize.SpanTokenizer // splits text into words
+	Unknown  string                 // replaces words missing from the vocabulary
+	Prefix   string                 // marks subwords that continue a word
+	MaxChars int                    // longer words are replaced by Unknown
+
+	vocab *vocabulary
+}
+
+func newWordPiece(vocab *vocabulary) *WordPiece {
+	return &WordPiece{
+		Words: newWordTokenizer(), Unknown: "[UNK]", Prefix: "##",
+		MaxChars: 100, vocab: vocab}
+}
+
+// NewWordPiece creates a new WordPiece model from a vocabulary (one subword
+// per line, whose line number is its ID) read from vocab.
+func NewWordPiece(vocab io.Reader) (*WordPiece, error) {
+	v, err := readVocabulary(vocab)
+	if err != nil {
+		return nil, err
+	}
+	return newWordPiece(v), nil
+}
+
+// WordPiece learns a WordPiece model with a vocabulary of size subwords from
+// the Trainer's corpus.
+//
+// The vocabulary starts with BERT's special tokens (e.g., "[UNK]" and
+// "[CLS]") and every character in the corpus, which are never left out. Pairs
+// of subwords are then merged, as in BPE, until the vocabulary is full or no
+// pair of subwords occurs more than once. So, as with BPE, the vocabulary may
+// be smaller or larger than size. Rather than the most frequent pair, each step merges
+// the pair that is the most frequent relative to its parts (i.e., with the
+// highest count(ab) / (count(a) * count(b))).
+func (t *Trainer) WordPiece(size int) *WordPiece {
+	w := newWordPiece(nil)
+	w.Words = t.Words
+
+	m := newMerger(t.counts, func(word string) []string {
+		symbols := []string{}
+		for i, sym := range splitRunes(word) {
+			if i > 0 {
+				sym.text = w.Prefix + sym.text
+			}
+			symbols = append(symbols, sym.text)
+		}
+		return symbols
+	})
+
+	w.vocab = newVocabulary()
+	for _, token := range specialTokens {
+		w.vocab.add(token)
+	}
+	for _, sym := range m.symbols() {
+		w.vocab.add(sym)
+	}
+
+	for len(w.vocab.tokens) < size {
+		pair, found := m.best(func(count, a, b int) float64 {
+			return float64(count) / (float64(a) * float64(b))
+		})
+		if !found {
+			break
+		}
+		merged := pair[0] + strings.TrimPrefix(pair[1], w.Prefix)
+		m.merge(pair, merged)
+		w.vocab.add(merged)
+	}
+
+	return w
+}
+
+// Save writes the model's vocabulary to vocab, in the format read by
+// NewWordPiece.
+func (w WordPiece) Save(vocab io.Writer) error {
+	return w.vocab.write(vocab)
+}
+
+// Tokenize splits text into a slice of subwords.
+func (w WordPiece) Tokenize(text string) []string {
+	tokens := []string{}
+	for _, tok := range w.Encode(text) {
+		tokens = append(tokens, tok.Text)
+	}
+	return tokens
+}
+
+// Encode splits text into subwords, returning each one's ID and location.
+//
+// If Unknown isn't in the vocabulary, its ID is -1.
+func (w WordPiece) Encode(text string) []Token {
+	return encodeWords(text, w.Words, w.vocab, w.split)
+}
+
+// Decode converts a slice of IDs into text, separating words with a single
+// space. IDs that aren't in the vocabulary are ignored.
+func (w WordPiece) Decode(ids []int) string {
+	var buf bytes.Buffer
+	for _, id := range ids {
+		if token, found := w.vocab.token(id); found {
+			if buf.Len() > 0 && strings.HasPrefix(token, w.Prefix) {
+				token = strings.TrimPrefix(token, w.Prefix)
+			} else if buf.Len() > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(token)
+		}
+	}
+	return buf.String()
+}
+
+// split divides word into the longest subwords in the vocabulary, from left
+// to right.
+func (w WordPiece) split(word string) []symbol {
+	unknown := []symbol{{text: w.Unknown, start: 0, end: len(word)}}
+	if utf8.RuneCountInString(word) > w.MaxChars {
+		return unknown
+	}
+
+	symbols := []symbol{}
+	for start := 0; start < len(word); {
+		end := len(word)
+		for ; end > start; end -= lastRuneLen(word[start:end]) {
+			piece := word[start:end]
+			if start > 0 {
+				piece = w.Prefix + piece
+			}
+			if w.vocab.id(piece) >= 0 {
+				symbols = append(symbols, symbol{text: piece, start: start, end: end})
+				break
+			}
+		}
+		if end == start {
+			return unknown
+		}
+		start = end
+	}
+	return symbols
+}
+
+// lastRuneLen returns the width, in bytes, of the last character in s.
+func lastRuneLen(s string) int {
+	_, size := utf8.DecodeLastRuneInString(s)
+	return size
+}
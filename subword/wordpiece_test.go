@@ -0,0 +1,84 @@
+package subword
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleNewWordPiece() {
+	vocab := strings.NewReader(strings.Join([]string{
+		"[UNK]", "un", "##aff", "##able", "play", "##ing", ","}, "\n"))
+
+	model, err := NewWordPiece(vocab)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(model.Tokenize("unaffable, playing xyz"))
+	// Output: [un ##aff ##able , play ##ing [UNK]]
+}
+
+func TestWordPiece(t *testing.T) {
+	text := getSherlock()
+	trainer := NewTrainer()
+	trainer.Train(text)
+
+	model := trainer.WordPiece(1000)
+	assert.Equal(t, 1000, len(model.vocab.tokens))
+	assert.Equal(t, specialTokens, model.vocab.tokens[:len(specialTokens)])
+
+	var vocab bytes.Buffer
+	util.CheckError(model.Save(&vocab))
+	loaded, err := NewWordPiece(&vocab)
+	util.CheckError(err)
+
+	tokens := model.Encode(text)
+	assert.Equal(t, tokens, loaded.Encode(text))
+
+	ids := []int{}
+	for _, tok := range tokens {
+		if tok.ID < 0 || strings.TrimPrefix(tok.Text, "##") != text[tok.Start:tok.End] {
+			t.Fatalf("bad token: %+v", tok)
+		}
+		ids = append(ids, tok.ID)
+	}
+	assert.Equal(t, strings.Join(model.Words.Tokenize(text), " "), model.Decode(ids))
+}
+
+func TestWordPieceOffsets(t *testing.T) {
+	vocab := strings.NewReader(strings.Join([]string{
+		"[UNK]", "na", "##ïve", "café"}, "\n"))
+	model, err := NewWordPiece(vocab)
+	util.CheckError(err)
+
+	spans := [][4]int{}
+	for _, tok := range model.Encode("café naïve") {
+		spans = append(spans, [4]int{tok.Start, tok.End, tok.RuneStart, tok.RuneEnd})
+	}
+	assert.Equal(t, [][4]int{{0, 5, 0, 4}, {6, 8, 5, 7}, {8, 12, 7, 10}}, spans)
+}
+
+func TestWordPieceUnknown(t *testing.T) {
+	vocab := strings.NewReader(strings.Join([]string{"[UNK]", "a", "##a"}, "\n"))
+	model, err := NewWordPiece(vocab)
+	util.CheckError(err)
+
+	assert.Equal(t, []string{"a", "##a", "##a", "[UNK]"}, model.Tokenize("aaa aab"))
+	model.MaxChars = 2
+	assert.Equal(t, []string{"[UNK]"}, model.Tokenize("aaa"))
+	assert.Equal(t, "aaa [UNK]", model.Decode([]int{1, 2, 2, 0, 42}))
+}
+
+func TestWordPieceSmallVocabulary(t *testing.T) {
+	trainer := NewTrainer()
+	trainer.Train("abc abc abd xyz")
+
+	model := trainer.WordPiece(3)
+	assert.Equal(t, trainer.WordPiece(0).vocab.tokens, model.vocab.tokens)
+	assert.True(t, len(model.vocab.tokens) > 3)
+	assert.NotContains(t, model.Tokenize("abc abd xyz"), "[UNK]")
+}
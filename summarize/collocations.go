@@ -0,0 +1,240 @@
+package summarize
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jdkato/prose/internal/util"
+)
+
+// A Measure is a statistical test used to score how strongly the words of an
+// n-gram are associated.
+type Measure int
+
+const (
+	// PMI is pointwise mutual information: the log (base 2) of how much more
+	// often an n-gram occurs than it would if its words were independent. It
+	// favors rare n-grams, so it's best used with a CollocationFinder's
+	// MinFreq.
+	PMI Measure = iota
+
+	// LogLikelihood is Dunning's log-likelihood ratio.
+	LogLikelihood
+
+	// ChiSquare is Pearson's chi-square test.
+	ChiSquare
+
+	// TTest is Student's t-test.
+	TTest
+)
+
+// A Collocation is an n-gram along with the number of times it occurs and its
+// score according to a Measure.
+type Collocation struct {
+	Words []string
+	Count int
+	Score float64
+}
+
+// CollocationFinder finds the n-grams in a Document that occur together more
+// often than would be expected by chance (e.g., "machine learning").
+//
+// Words are converted to lowercase, and n-grams never span sentence
+// boundaries or tokens without any letters or numbers (e.g., punctuation).
+type CollocationFinder struct {
+	// MinFreq is the minimum number of times an n-gram must occur to be
+	// scored.
+	MinFreq int
+
+	// FilterStopWords, if true, ignores n-grams that begin or end with a
+	// stop word (so "point of view" is kept while "of the" isn't).
+	FilterStopWords bool
+
+	n       int
+	windows float64
+	counts  map[string]int // [pattern]count; see patternKey
+}
+
+// MaxCollocationLength is the longest n-gram a CollocationFinder can score.
+const MaxCollocationLength = 5
+
+// NewCollocationFinder creates a new CollocationFinder for the n-grams of
+// length n (typically 2 or 3) in d. By default, n-grams that occur only once
+// or begin or end with a stop word are ignored.
+//
+// An error is returned if n isn't between 2 and MaxCollocationLength.
+func NewCollocationFinder(d *Document, n int) (*CollocationFinder, error) {
+	if n < 2 || n > MaxCollocationLength {
+		return nil, fmt.Errorf(
+			"n must be between 2 and %d, not %d", MaxCollocationLength, n)
+	}
+
+	f := CollocationFinder{
+		MinFreq: 2, FilterStopWords: true, n: n, counts: make(map[string]int)}
+
+	for _, s := range d.Sentences {
+		run := []string{}
+		for i := 0; i <= len(s.Words); i++ {
+			if i < len(s.Words) && strings.IndexFunc(s.Words[i].Text, isWordChar) >= 0 {
+				run = append(run, strings.ToLower(s.Words[i].Text))
+				continue
+			}
+			for j := 0; j+n <= len(run); j++ {
+				f.add(run[j : j+n])
+			}
+			run = run[:0]
+		}
+	}
+
+	return &f, nil
+}
+
+// MustNewCollocationFinder is like NewCollocationFinder, but it panics if n
+// is out of range.
+func MustNewCollocationFinder(d *Document, n int) *CollocationFinder {
+	f, err := NewCollocationFinder(d, n)
+	util.CheckError(err)
+	return f
+}
+
+// Collocations returns the n-grams found by f, ranked by m.
+func (f CollocationFinder) Collocations(m Measure) []Collocation {
+	full := 1<<uint(f.n) - 1
+	prefix := strconv.Itoa(full) + "\x00"
+
+	collocations := []Collocation{}
+	for pattern, count := range f.counts {
+		if count < f.MinFreq || !strings.HasPrefix(pattern, prefix) {
+			continue
+		}
+		words := strings.Split(strings.TrimPrefix(pattern, prefix), "\x00")
+		if f.FilterStopWords && (util.StringInSlice(words[0], stopWords) ||
+			util.StringInSlice(words[len(words)-1], stopWords)) {
+			continue
+		}
+		collocations = append(collocations, Collocation{
+			Words: words, Count: count, Score: f.score(words, m)})
+	}
+
+	sort.Sort(byScore(collocations))
+	return collocations
+}
+
+// add counts every pattern matched by the n-gram words.
+func (f *CollocationFinder) add(words []string) {
+	f.windows++
+	for mask := 1; mask < 1<<uint(f.n); mask++ {
+		f.counts[patternKey(words, mask)]++
+	}
+}
+
+// patternKey identifies the pattern formed by the words of an n-gram whose positions
+// are in mask (e.g., mask 5 of "a b c" is "a _ c").
+func patternKey(words []string, mask int) string {
+	parts := []string{strconv.Itoa(mask)}
+	for i, w := range words {
+		if mask&(1<<uint(i)) != 0 {
+			parts = append(parts, w)
+		}
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// score computes m for words.
+//
+// Each n-gram in the Document is compared to words position-by-position,
+// which gives a contingency table with a cell for every subset of positions:
+// the number of n-grams that match words in exactly those positions. The
+// expected value of each cell assumes that the words are independent.
+func (f CollocationFinder) score(words []string, m Measure) float64 {
+	full := 1<<uint(f.n) - 1
+	observed := float64(f.counts[patternKey(words, full)])
+
+	p := make([]float64, f.n)
+	expected := f.windows
+	for i := range words {
+		p[i] = float64(f.counts[patternKey(words, 1<<uint(i))]) / f.windows
+		expected *= p[i]
+	}
+
+	switch m {
+	case PMI:
+		return math.Log2(observed / expected)
+	case TTest:
+		return (observed - expected) / math.Sqrt(observed)
+	}
+
+	score := 0.0
+	for cell := 0; cell <= full; cell++ {
+		o, e := f.cell(words, cell), f.windows
+		for i := range words {
+			if cell&(1<<uint(i)) != 0 {
+				e *= p[i]
+			} else {
+				e *= 1 - p[i]
+			}
+		}
+		if m == ChiSquare && e > 0 {
+			score += (o - e) * (o - e) / e
+		} else if m == LogLikelihood && o > 0 {
+			score += 2 * o * math.Log(o/e)
+		}
+	}
+	return score
+}
+
+// cell returns the number of n-grams that match words in exactly the
+// positions in mask, using the inclusion-exclusion principle.
+func (f CollocationFinder) cell(words []string, mask int) float64 {
+	full := 1<<uint(f.n) - 1
+	total := 0.0
+	for super := full; ; super = (super - 1) & full {
+		if super&mask == mask {
+			count := f.windows
+			if super != 0 {
+				count = float64(f.counts[patternKey(words, super)])
+			}
+			if bits(super^mask)%2 == 0 {
+				total += count
+			} else {
+				total -= count
+			}
+		}
+		if super == 0 {
+			break
+		}
+	}
+	return total
+}
+
+// bits returns the number of set bits in x.
+func bits(x int) int {
+	count := 0
+	for ; x != 0; x &= x - 1 {
+		count++
+	}
+	return count
+}
+
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// byScore sorts Collocations from the highest to lowest score, breaking ties
+// by count and then alphabetically.
+type byScore []Collocation
+
+func (s byScore) Len() int      { return len(s) }
+func (s byScore) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byScore) Less(i, j int) bool {
+	if s[i].Score != s[j].Score {
+		return s[i].Score > s[j].Score
+	} else if s[i].Count != s[j].Count {
+		return s[i].Count > s[j].Count
+	}
+	return strings.Join(s[i].Words, " ") < strings.Join(s[j].Words, " ")
+}
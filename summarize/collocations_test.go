@@ -0,0 +1,82 @@
+package summarize
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleCollocationFinder() {
+	d := NewDocument(
+		"Machine learning is a field of study. Deep learning is a kind of " +
+			"machine learning. Machine learning uses data.")
+	f := MustNewCollocationFinder(d, 2)
+	for _, c := range f.Collocations(LogLikelihood) {
+		fmt.Println(c.Words, c.Count)
+	}
+	// Output: [machine learning] 3
+}
+
+func TestCollocationScores(t *testing.T) {
+	d := NewDocument("New York is big. I love New York. New York is old.")
+	f := MustNewCollocationFinder(d, 2)
+
+	for m, expected := range map[Measure]float64{
+		PMI: 1.584963, LogLikelihood: 11.457255, ChiSquare: 9, TTest: 1.154701,
+	} {
+		collocations := f.Collocations(m)
+		if assert.Len(t, collocations, 1) {
+			assert.Equal(t, []string{"new", "york"}, collocations[0].Words)
+			assert.Equal(t, 3, collocations[0].Count)
+			assert.InDelta(t, expected, collocations[0].Score, 1e-6)
+		}
+	}
+
+	f.FilterStopWords = false
+	collocations := f.Collocations(PMI)
+	assert.Len(t, collocations, 2)
+	assert.Equal(t, []string{"york", "is"}, collocations[0].Words)
+
+	f.MinFreq = 1
+	assert.Len(t, f.Collocations(PMI), 6)
+}
+
+func TestCollocations(t *testing.T) {
+	text := util.ReadDataFile(filepath.Join(testdata, "article.txt"))
+	d := NewDocument(string(text))
+
+	bigrams := MustNewCollocationFinder(d, 2)
+	for _, m := range []Measure{LogLikelihood, TTest} {
+		top := bigrams.Collocations(m)[0]
+		assert.Equal(t, []string{"minimum", "wage"}, top.Words)
+		assert.Equal(t, 5, top.Count)
+	}
+	for _, c := range bigrams.Collocations(PMI) {
+		assert.True(t, c.Count >= 2)
+	}
+
+	trigrams := MustNewCollocationFinder(d, 3)
+	collocations := trigrams.Collocations(ChiSquare)
+	if assert.Len(t, collocations, 2) {
+		assert.Equal(t, []string{"voted", "to", "raise"}, collocations[0].Words)
+		assert.Equal(t, []string{"use", "of", "drones"}, collocations[1].Words)
+	}
+}
+
+func TestNewCollocationFinderError(t *testing.T) {
+	d := NewDocument("New York is big. I love New York.")
+	for _, n := range []int{-1, 0, 1, MaxCollocationLength + 1, 64} {
+		f, err := NewCollocationFinder(d, n)
+		assert.Nil(t, f)
+		assert.NotNil(t, err)
+	}
+	_, err := NewCollocationFinder(d, 0)
+	assert.EqualError(t, err, "n must be between 2 and 5, not 0")
+	_, err = NewCollocationFinder(d, MaxCollocationLength)
+	assert.Nil(t, err)
+
+	assert.Panics(t, func() { MustNewCollocationFinder(d, 1) })
+}
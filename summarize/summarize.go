@@ -9,6 +9,7 @@ import (
 	"unicode"
 
 	"github.com/jdkato/prose/internal/util"
+	"github.com/jdkato/prose/stem"
 	"github.com/jdkato/prose/tokenize"
 
 	"github.com/montanaflynn/stats"
@@ -58,6 +59,10 @@ type Document struct {
 
 	SentenceTokenizer tokenize.ProseTokenizer
 	WordTokenizer     tokenize.ProseTokenizer
+
+	// Stemmer, if set, causes Keywords to group words by their stem (e.g.,
+	// "run", "runs", and "running" are all counted as "run").
+	Stemmer stem.Stemmer
 }
 
 // An Assessment provides comprehensive access to a Document's metrics.
@@ -84,10 +89,11 @@ type Assessment struct {
 //
 // This is a convenience wrapper around the Document initialization process
 // that defaults to using a WordBoundaryTokenizer and a PunktSentenceTokenizer
-// as its word and sentence tokenizers, respectively.
+// as its word and sentence tokenizers, respectively. (For non-English text,
+// consider a Document with a tokenize.UnicodeWordTokenizer instead.)
 func NewDocument(text string) *Document {
 	wTok := tokenize.NewWordBoundaryTokenizer()
-	sTok := tokenize.NewPunktSentenceTokenizer()
+	sTok := tokenize.MustNewPunktSentenceTokenizer()
 	doc := Document{Content: text, WordTokenizer: wTok, SentenceTokenizer: sTok}
 	doc.Initialize()
 	return &doc
@@ -95,9 +101,13 @@ func NewDocument(text string) *Document {
 
 // Initialize calculates the data necessary for computing readability and usage
 // statistics.
+//
+// Paragraphs are separated by "\n\n", unless the Document's sentence
+// tokenizer is a tokenize.ParagraphSplitter. So, hard-wrapped text is
+// supported by a tokenize.PragmaticSegmenter with a DocType of tokenize.PDF.
 func (d *Document) Initialize() {
 	d.WordFrequency = make(map[string]int)
-	for i, paragraph := range strings.Split(d.Content, "\n\n") {
+	for i, paragraph := range d.paragraphs() {
 		for _, s := range d.SentenceTokenizer.Tokenize(paragraph) {
 			wordCount := d.NumWords
 			d.NumSentences++
@@ -158,6 +168,14 @@ func (d *Document) Assess() *Assessment {
 	return &a
 }
 
+// paragraphs splits the Document's content into paragraphs.
+func (d *Document) paragraphs() []string {
+	if p, ok := d.SentenceTokenizer.(tokenize.ParagraphSplitter); ok {
+		return p.SplitParagraphs(d.Content)
+	}
+	return strings.Split(d.Content, "\n\n")
+}
+
 // Summary returns a Document's n highest ranked paragraphs according to
 // keyword frequency.
 func (d *Document) Summary(n int) []RankedParagraph {
@@ -171,7 +189,11 @@ func (d *Document) Summary(n int) []RankedParagraph {
 			if s.Paragraph == i {
 				size += s.Length
 				for _, w := range s.Words {
-					if score, found := scores[w.Text]; found {
+					key := w.Text
+					if d.Stemmer != nil {
+						key = d.Stemmer.Stem(key)
+					}
+					if score, found := scores[key]; found {
 						rank += score
 					}
 				}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jdkato/prose/internal/util"
+	"github.com/jdkato/prose/tokenize"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,3 +63,39 @@ func TestSummarize(t *testing.T) {
 	}
 	fmt.Print(text)
 }
+
+func TestParagraphs(t *testing.T) {
+	text := "This is the first\nparagraph. It has two sentences.\n \nThis is the\nsecond one.\r\n\r\nThird."
+
+	sTok, err := tokenize.NewPragmaticSegmenter("en")
+	util.CheckError(err)
+	sTok.DocType = tokenize.PDF
+
+	d := Document{
+		Content: text, SentenceTokenizer: sTok,
+		WordTokenizer: tokenize.NewWordBoundaryTokenizer()}
+	d.Initialize()
+
+	assert.Equal(t, 3.0, d.NumParagraphs)
+	assert.Equal(t, 4.0, d.NumSentences)
+	assert.Equal(t, "This is the first paragraph.", d.Sentences[0].Text)
+	assert.Equal(t, 1, d.Sentences[2].Paragraph)
+
+	// Other documents are only split on "\n\n".
+	d = Document{
+		Content: text, SentenceTokenizer: tokenize.MustNewPunktSentenceTokenizer(),
+		WordTokenizer: tokenize.NewWordBoundaryTokenizer()}
+	d.Initialize()
+	assert.Equal(t, 1.0, d.NumParagraphs)
+}
+
+func TestDocumentUnicode(t *testing.T) {
+	d := Document{
+		Content:           "Le garçon a mangé l’orange. Ça coûte 1,50 €.",
+		WordTokenizer:     tokenize.NewUnicodeWordTokenizer(),
+		SentenceTokenizer: tokenize.MustNewPunktSentenceTokenizer()}
+	d.Initialize()
+	assert.Equal(t, 2.0, d.NumSentences)
+	assert.Equal(t, 8.0, d.NumWords)
+	assert.Equal(t, 1, d.WordFrequency["l’orange"])
+}
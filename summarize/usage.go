@@ -21,7 +21,8 @@ func (d *Document) WordDensity() map[string]float64 {
 //
 //    map[word]count
 //
-// omitting stop words and normalizing case.
+// omitting stop words and normalizing case. If the Document has a Stemmer,
+// words are grouped by (and keyed on) their stem instead.
 func (d *Document) Keywords() map[string]int {
 	scores := map[string]int{}
 	for word, freq := range d.WordFrequency {
@@ -29,6 +30,9 @@ func (d *Document) Keywords() map[string]int {
 		if util.StringInSlice(normalized, stopWords) {
 			continue
 		}
+		if d.Stemmer != nil {
+			normalized = d.Stemmer.Stem(word)
+		}
 		if _, found := scores[normalized]; found {
 			scores[normalized] += freq
 		} else {
@@ -3,6 +3,7 @@ package summarize
 import (
 	"testing"
 
+	"github.com/jdkato/prose/stem"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,3 +23,16 @@ func TestUsage(t *testing.T) {
 	assert.Equal(t, dmap, d.WordDensity())
 	assert.Equal(t, 5.163, d.MeanWordLength())
 }
+
+func TestKeywordsStemmed(t *testing.T) {
+	text := "She runs every day. Running is fun, and she will run again tomorrow."
+	d := NewDocument(text)
+	assert.Equal(t, 1, d.Keywords()["runs"])
+	assert.Equal(t, 1, d.Keywords()["running"])
+
+	d.Stemmer = stem.NewEnglishStemmer()
+	keywords := d.Keywords()
+	assert.Equal(t, 3, keywords["run"])
+	assert.Equal(t, 1, keywords["fun"])
+	assert.NotContains(t, keywords, "runs")
+}
@@ -21,6 +21,12 @@
 package tag
 
 import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
 	"regexp"
 	"strconv"
 	"strings"
@@ -55,27 +61,61 @@ func NewAveragedPerceptron(weights map[string]map[string]float64,
 // PerceptronTagger is a port of Textblob's "fast and accurate" POS tagger.
 // See https://github.com/sloria/textblob-aptagger for details.
 type PerceptronTagger struct {
-	tagMap map[string]string
+	// Rand, if set, is used by Train to shuffle its sentences between
+	// iterations; otherwise, the global source in math/rand is used. Setting
+	// Rand to a source with a fixed seed makes training reproducible.
+	Rand *rand.Rand
+
+	// BeamWidth, if greater than 1, makes Tag use a beam search that keeps
+	// the BeamWidth most likely tag sequences while decoding, rather than
+	// committing to each word's most likely tag in turn. Wider beams recover
+	// from more early mistakes but are slower. It doesn't affect Train.
+	BeamWidth int
+
+	tagMap map[string]string // built from the sentences given to Train
 	model  *AveragedPerceptron
 }
 
+// ErrModelCorrupt is the kind of error returned when the built-in model can't
+// be loaded.
+var ErrModelCorrupt = util.ErrModelCorrupt
+
+// IsKind determines if err is of the given kind (e.g., ErrModelCorrupt).
+func IsKind(err, kind error) bool {
+	return util.IsKind(err, kind)
+}
+
 // NewPerceptronTagger creates a new PerceptronTagger and loads the built-in
 // AveragedPerceptron model.
-func NewPerceptronTagger() *PerceptronTagger {
+//
+// If the model can't be decoded, the returned error is of the kind
+// ErrModelCorrupt (i.e., IsKind(err, ErrModelCorrupt) is true).
+func NewPerceptronTagger() (*PerceptronTagger, error) {
 	var wts map[string]map[string]float64
 	var tags map[string]string
 	var classes []string
 
-	dec := model.GetAsset("classes.gob")
-	util.CheckError(dec.Decode(&classes))
-
-	dec = model.GetAsset("tags.gob")
-	util.CheckError(dec.Decode(&tags))
+	for _, asset := range []struct {
+		name string
+		v    interface{}
+	}{{"classes.gob", &classes}, {"tags.gob", &tags}, {"weights.gob", &wts}} {
+		dec, err := model.GetAsset(asset.name)
+		if err != nil {
+			return nil, err
+		} else if err = dec.Decode(asset.v); err != nil {
+			return nil, util.NewError(ErrModelCorrupt, err)
+		}
+	}
 
-	dec = model.GetAsset("weights.gob")
-	util.CheckError(dec.Decode(&wts))
+	return &PerceptronTagger{model: NewAveragedPerceptron(wts, tags, classes)}, nil
+}
 
-	return &PerceptronTagger{model: NewAveragedPerceptron(wts, tags, classes)}
+// MustNewPerceptronTagger is like NewPerceptronTagger, but it panics if the
+// built-in model can't be loaded.
+func MustNewPerceptronTagger() *PerceptronTagger {
+	pt, err := NewPerceptronTagger()
+	util.CheckError(err)
+	return pt
 }
 
 // Weights returns the model's weights in the form
@@ -116,12 +156,60 @@ func NewTrainedPerceptronTagger(model *AveragedPerceptron) *PerceptronTagger {
 	return &PerceptronTagger{model: model}
 }
 
+// modelVersion is the version of the format written by Save.
+const modelVersion = 1
+
+// savedModel is the format written by Save: a gob-encoded AveragedPerceptron
+// along with the version of the format.
+type savedModel struct {
+	Version int
+	Classes []string
+	TagMap  map[string]string
+	Weights map[string]map[string]float64
+}
+
+// Save writes the tagger's model--its weights, tag map, and classes--to w in a
+// versioned format that can be read by Load.
+func (pt *PerceptronTagger) Save(w io.Writer) error {
+	return gob.NewEncoder(w).Encode(savedModel{
+		Version: modelVersion, Classes: pt.model.classes,
+		TagMap: pt.model.tagMap, Weights: pt.model.weights})
+}
+
+// Load replaces the tagger's model with one read from r, which must have been
+// written by Save.
+//
+// If the model can't be decoded (or was saved in an unsupported version of the
+// format), the returned error is of the kind ErrModelCorrupt.
+func (pt *PerceptronTagger) Load(r io.Reader) error {
+	var m savedModel
+	if err := gob.NewDecoder(r).Decode(&m); err != nil {
+		return util.NewError(ErrModelCorrupt, err)
+	} else if m.Version != modelVersion {
+		return util.NewError(ErrModelCorrupt,
+			fmt.Errorf("unsupported version %d", m.Version))
+	}
+	pt.model = NewAveragedPerceptron(m.Weights, m.TagMap, m.Classes)
+	return nil
+}
+
 // Tag takes a slice of words and returns a slice of tagged tokens.
 func (pt *PerceptronTagger) Tag(words []string) []Token {
 	var tokens []Token
+	pt.decode(words, func(word, tag string, _ TagSource, _ map[string]float64) {
+		tokens = append(tokens, Token{Tag: tag, Text: word})
+	})
+	return tokens
+}
+
+// decode tags words, calling emit with each (non-empty) word, its tag, where
+// the tag came from, and--if it came from the model--the model's score for
+// each class.
+//
+// Unless pt.BeamWidth is greater than 1, words are tagged greedily from left
+// to right.
+func (pt *PerceptronTagger) decode(words []string, emit func(word, tag string, src TagSource, scores map[string]float64)) {
 	var clean []string
-	var tag string
-	var found bool
 
 	p1, p2 := "-START-", "-START2-"
 	context := []string{p1, p2}
@@ -133,20 +221,53 @@ func (pt *PerceptronTagger) Tag(words []string) []Token {
 		clean = append(clean, w)
 	}
 	context = append(context, []string{"-END-", "-END2-"}...)
+
+	if pt.BeamWidth > 1 {
+		pt.beamSearch(clean, context, emit)
+		return
+	}
 	for i, word := range clean {
-		if none.MatchString(word) {
-			tag = "-NONE-"
-		} else if keep.MatchString(word) {
-			tag = word
-		} else if tag, found = pt.model.tagMap[word]; !found {
-			tag = pt.model.predict(featurize(i, context, word, p1, p2))
+		tag, src, found := pt.fixedTag(word)
+		if found {
+			emit(word, tag, src, nil)
+		} else {
+			scores := pt.model.score(featurize(i, context, word, p1, p2))
+			tag = pt.model.best(scores)
+			emit(word, tag, SourceModel, scores)
 		}
-		tokens = append(tokens, Token{Tag: tag, Text: word})
 		p2 = p1
 		p1 = tag
 	}
+}
 
-	return tokens
+// fixedTag returns the tag of word if it doesn't depend on the model (i.e.,
+// it's determined by the word's form or found in the tag map).
+func (pt *PerceptronTagger) fixedTag(word string) (string, TagSource, bool) {
+	if none.MatchString(word) {
+		return "-NONE-", SourcePattern, true
+	} else if keep.MatchString(word) {
+		return word, SourcePattern, true
+	} else if tag, found := pt.model.tagMap[word]; found {
+		return tag, SourceTagMap, true
+	}
+	return "", SourceModel, false
+}
+
+// TagBatch is like Tag, but it tags many sentences at once using up to workers
+// goroutines (or one per CPU if workers < 1). The tokens of sentences[i] are
+// at index i of the returned slice.
+//
+// If ctx is canceled before every sentence has been tagged, TagBatch stops
+// early and returns ctx.Err(). It must not be called concurrently with Train.
+func (pt *PerceptronTagger) TagBatch(ctx context.Context, sentences [][]string, workers int) ([][]Token, error) {
+	tokens := make([][]Token, len(sentences))
+	err := util.ParallelFor(ctx, len(sentences), workers, func(i int) {
+		tokens[i] = pt.Tag(sentences[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
 }
 
 // Train an Averaged Perceptron model based on sentences.
@@ -177,7 +298,13 @@ func (pt *PerceptronTagger) Train(sentences TupleSlice, iterations int) {
 				p1 = guess
 			}
 		}
-		shuffle.Shuffle(sentences)
+		if pt.Rand != nil {
+			for j := len(sentences) - 1; j > 0; j-- {
+				sentences.Swap(j, pt.Rand.Intn(j+1))
+			}
+		} else {
+			shuffle.Shuffle(sentences)
+		}
 	}
 	pt.model.averageWeights()
 }
@@ -195,16 +322,27 @@ func (pt *PerceptronTagger) makeTagMap(sentences TupleSlice) {
 			pt.model.addClass(tag)
 		}
 	}
+	pt.tagMap = make(map[string]string)
+	if pt.model.tagMap == nil {
+		pt.model.tagMap = make(map[string]string)
+	}
 	for word, tagFreqs := range counts {
 		tag, mode := maxValue(tagFreqs)
 		n := float64(sumValues(tagFreqs))
 		if n >= 20 && (float64(mode)/n) >= 0.97 {
 			pt.tagMap[word] = tag
+			pt.model.tagMap[word] = tag
 		}
 	}
 }
 
 func (ap *AveragedPerceptron) predict(features map[string]float64) string {
+	return ap.best(ap.score(features))
+}
+
+// score returns the score of each class (that has a weight for any of
+// features) given features.
+func (ap *AveragedPerceptron) score(features map[string]float64) map[string]float64 {
 	var weights map[string]float64
 	var found bool
 
@@ -221,7 +359,7 @@ func (ap *AveragedPerceptron) predict(features map[string]float64) string {
 			}
 		}
 	}
-	return max(scores)
+	return scores
 }
 
 func (ap *AveragedPerceptron) update(truth, guess string, feats map[string]float64) {
@@ -270,15 +408,25 @@ func (ap *AveragedPerceptron) averageWeights() {
 	}
 }
 
-func max(scores map[string]float64) string {
-	var class string
-	max := 0.0
-	for label, value := range scores {
-		if value > max {
-			max = value
-			class = label
+// best returns the class with the highest score, where classes missing from
+// scores have a score of 0. Ties are broken alphabetically (as in byScore), so
+// the result doesn't depend on map order; it's only "" if there are no
+// classes at all.
+func (ap *AveragedPerceptron) best(scores map[string]float64) string {
+	class, max := "", math.Inf(-1)
+	consider := func(label string, score float64) {
+		if score > max || (score == max && label < class) {
+			class, max = label, score
 		}
 	}
+	for _, label := range ap.classes {
+		if _, found := scores[label]; !found {
+			consider(label, 0)
+		}
+	}
+	for label, score := range scores {
+		consider(label, score)
+	}
 	return class
 }
 
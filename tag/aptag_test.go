@@ -1,8 +1,12 @@
 package tag
 
 import (
+	"bytes"
+	"context"
+	"encoding/gob"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,7 +35,7 @@ func ExampleReadTagged() {
 }
 
 func TestTrain(t *testing.T) {
-	tagger := NewPerceptronTagger()
+	tagger := MustNewPerceptronTagger()
 	sentences := ReadTagged(wsj, "|")
 	iter := random(5, 20)
 	tagger.Train(sentences, iter)
@@ -51,7 +55,130 @@ func TestTrain(t *testing.T) {
 	assert.Subset(t, tagger.Classes(), tagSet)
 }
 
+func TestTagBatch(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+	sentences := [][]string{}
+	for _, tuple := range ReadTagged(wsj, "|") {
+		for i := 0; i < 50; i++ {
+			sentences = append(sentences, tuple[0][i%len(tuple[0]):])
+		}
+	}
+
+	tokens, err := tagger.TagBatch(context.Background(), sentences, 4)
+	util.CheckError(err)
+	if assert.Equal(t, len(sentences), len(tokens)) {
+		for i, words := range sentences {
+			assert.Equal(t, tagger.Tag(words), tokens[i])
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tokens, err = tagger.TagBatch(ctx, sentences, 4)
+	assert.Nil(t, tokens)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestSaveLoad(t *testing.T) {
+	tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+		make(map[string]map[string]float64), make(map[string]string), []string{}))
+
+	sentences := TupleSlice{}
+	for i := 0; i < 20; i++ {
+		sentences = append(sentences, ReadTagged(wsj, "|")...)
+	}
+	tagger.Train(sentences, 2)
+	assert.Equal(t, "DT", tagger.TagMap()["the"])
+
+	var buf bytes.Buffer
+	util.CheckError(tagger.Save(&buf))
+
+	loaded := &PerceptronTagger{}
+	util.CheckError(loaded.Load(&buf))
+	assert.Equal(t, tagger.Weights(), loaded.Weights())
+	assert.Equal(t, tagger.TagMap(), loaded.TagMap())
+	assert.Equal(t, tagger.Classes(), loaded.Classes())
+
+	words := strings.Fields("Mr. Vinken is a nonexecutive director of the group .")
+	assert.Equal(t, tagger.Tag(words), loaded.Tag(words))
+}
+
+func TestLoadError(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+
+	err := tagger.Load(strings.NewReader("not a model"))
+	assert.True(t, IsKind(err, ErrModelCorrupt))
+
+	var buf bytes.Buffer
+	util.CheckError(gob.NewEncoder(&buf).Encode(savedModel{Version: 99}))
+	err = tagger.Load(&buf)
+	assert.True(t, IsKind(err, ErrModelCorrupt))
+	assert.EqualError(t, err, "corrupt model: unsupported version 99")
+
+	// A failed Load leaves the existing model in place.
+	assert.Equal(t, "NNP", tagger.Tag([]string{"Pierre", "Vinken"})[0].Tag)
+}
+
 func random(min, max int) int {
-	rand.Seed(time.Now().Unix())
-	return rand.Intn(max-min) + min
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	return r.Intn(max-min) + min
 }
+
+func TestTagBeam(t *testing.T) {
+	// Greedy decoding commits to "x"/A (which is only slightly more likely
+	// than B), after which "y" is a toss-up; "x"/B makes "y"/B almost certain.
+	tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+		map[string]map[string]float64{
+			"i word x":           {"A": 1, "B": 0.9},
+			"i-1 tag+i word A y": {"A": 0.1},
+			"i-1 tag+i word B y": {"B": 5},
+		},
+		make(map[string]string), []string{"A", "B"}))
+	words := []string{"x", "y"}
+
+	assert.Equal(t, []Token{{"x", "A"}, {"y", "A"}}, tagger.Tag(words))
+	tagger.BeamWidth = 2
+	assert.Equal(t, []Token{{"x", "B"}, {"y", "B"}}, tagger.Tag(words))
+
+	scored := tagger.TagWithScores(words, 1)
+	assert.Equal(t, "B", scored[0].Tag)
+	assert.Equal(t, "A", scored[0].Tags[0].Tag)
+}
+
+func TestTagBeamModel(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+	sentences := ReadTagged(wsj, "|")
+
+	greedy := Evaluate(tagger, sentences)
+	tagger.BeamWidth = 1
+	assert.Equal(t, greedy, Evaluate(tagger, sentences))
+
+	tagger.BeamWidth = 5
+	beam := Evaluate(tagger, sentences)
+	assert.Equal(t, greedy.Tokens, beam.Tokens)
+	assert.True(t, beam.Accuracy >= greedy.Accuracy)
+
+	words := strings.Fields("Mr. Vinken is chairman of the flibbertigibbet group .")
+	tokens := tagger.Tag(words)
+	for i, tok := range tagger.TagWithScores(words, 0) {
+		assert.Equal(t, tokens[i], tok.Token)
+	}
+}
+
+func benchmarkTag(b *testing.B, width int) {
+	tagger := MustNewPerceptronTagger()
+	tagger.BeamWidth = width
+	sentences := ReadTagged(wsj, "|")
+	b.Logf("accuracy: %f", Evaluate(tagger, sentences).Accuracy)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, tuple := range sentences {
+			tagger.Tag(tuple[0])
+		}
+	}
+}
+
+func BenchmarkTagGreedy(b *testing.B) { benchmarkTag(b, 0) }
+
+func BenchmarkTagBeam(b *testing.B) { benchmarkTag(b, 5) }
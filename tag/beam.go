@@ -0,0 +1,82 @@
+package tag
+
+import "sort"
+
+// A hypothesis is a partial tag sequence considered by beamSearch. Its tags
+// are stored as a linked list, from last to first, so that hypotheses can
+// share their common prefixes.
+type hypothesis struct {
+	prev    *hypothesis
+	tag     string
+	src     TagSource
+	scores  map[string]float64
+	logProb float64 // the sum of the log-probabilities of the tags so far
+}
+
+// beamSearch tags words using a beam search: at each word, every one of the
+// (at most pt.BeamWidth) best sequences found so far is extended by each of
+// its most likely next tags, and the best pt.BeamWidth of the results are
+// kept. A sequence's probability is the product of its tags' probabilities,
+// each of which is estimated by the softmax of the model's scores.
+//
+// Since the features of a word only depend on the previous two tags, only
+// the best of the sequences that end in the same two tags is kept.
+func (pt *PerceptronTagger) beamSearch(words, context []string, emit func(word, tag string, src TagSource, scores map[string]float64)) {
+	start := &hypothesis{prev: &hypothesis{tag: "-START2-"}, tag: "-START-"}
+	beam := []*hypothesis{start}
+	for i, word := range words {
+		candidates := []*hypothesis{}
+		tag, src, found := pt.fixedTag(word)
+		for _, h := range beam {
+			if found {
+				candidates = append(candidates, &hypothesis{
+					prev: h, tag: tag, src: src, logProb: h.logProb})
+				continue
+			}
+			scores := pt.model.score(featurize(i, context, word, h.tag, h.prev.tag))
+			tags := pt.model.logSoftmax(scores)
+			if len(tags) == 0 {
+				// The model has no classes, so (like best) we give up.
+				tags = []ScoredTag{{}}
+			}
+			for j, next := range tags {
+				if j == pt.BeamWidth {
+					break
+				}
+				candidates = append(candidates, &hypothesis{
+					prev: h, tag: next.Tag, src: SourceModel, scores: scores,
+					logProb: h.logProb + next.Score})
+			}
+		}
+
+		sort.Stable(byLogProb(candidates))
+		beam = []*hypothesis{}
+		seen := make(map[string]bool)
+		for _, c := range candidates {
+			key := c.prev.tag + " " + c.tag
+			if !seen[key] {
+				seen[key] = true
+				beam = append(beam, c)
+				if len(beam) == pt.BeamWidth {
+					break
+				}
+			}
+		}
+	}
+
+	best := []*hypothesis{}
+	for h := beam[0]; h != start; h = h.prev {
+		best = append(best, h)
+	}
+	for i, word := range words {
+		h := best[len(best)-1-i]
+		emit(word, h.tag, h.src, h.scores)
+	}
+}
+
+// byLogProb sorts hypotheses from most to least likely.
+type byLogProb []*hypothesis
+
+func (s byLogProb) Len() int           { return len(s) }
+func (s byLogProb) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byLogProb) Less(i, j int) bool { return s[i].logProb > s[j].logProb }
@@ -0,0 +1,160 @@
+package tag
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// A TagScore is a tagger's precision, recall, and F1 score for a single tag.
+type TagScore struct {
+	Precision float64
+	Recall    float64
+	F1        float64
+	Support   int // the number of tokens that should have been given the tag
+}
+
+// An Evaluation describes how well a tagger reproduces the tags of a set of
+// sentences.
+//
+// A word is considered known if the model has seen it during training (i.e.,
+// it's in the tag map or the model has a feature for it).
+type Evaluation struct {
+	Tokens  int // the number of tokens evaluated
+	Known   int // the number of those tokens that were known words
+	Unknown int // the number of those tokens that were unknown words
+
+	Accuracy        float64
+	KnownAccuracy   float64
+	UnknownAccuracy float64
+
+	Tags      map[string]TagScore       // [tag]score
+	Confusion map[string]map[string]int // [expected][predicted]count
+}
+
+// Evaluate tags the words of each sentence in sentences and compares the
+// results to their given tags.
+func Evaluate(tagger *PerceptronTagger, sentences TupleSlice) *Evaluation {
+	e := Evaluation{
+		Tags: make(map[string]TagScore), Confusion: make(map[string]map[string]int)}
+
+	correct, knownCorrect := 0, 0
+	for _, tuple := range sentences {
+		words, tags := []string{}, []string{}
+		for i, word := range tuple[0] {
+			// Tag skips empty words, so they can't be compared.
+			if word != "" {
+				words = append(words, word)
+				tags = append(tags, tuple[1][i])
+			}
+		}
+
+		for i, tok := range tagger.Tag(words) {
+			expected := tags[i]
+			if e.Confusion[expected] == nil {
+				e.Confusion[expected] = make(map[string]int)
+			}
+			e.Confusion[expected][tok.Tag]++
+
+			known := tagger.isKnown(tok.Text)
+			if known {
+				e.Known++
+			} else {
+				e.Unknown++
+			}
+			if tok.Tag == expected {
+				correct++
+				if known {
+					knownCorrect++
+				}
+			}
+			e.Tokens++
+		}
+	}
+
+	e.Accuracy = ratio(correct, e.Tokens)
+	e.KnownAccuracy = ratio(knownCorrect, e.Known)
+	e.UnknownAccuracy = ratio(correct-knownCorrect, e.Unknown)
+
+	predicted := make(map[string]int)
+	for _, counts := range e.Confusion {
+		for tag, count := range counts {
+			predicted[tag] += count
+		}
+	}
+	for tag := range predicted {
+		// Tags that were never expected have no correct predictions.
+		e.Tags[tag] = TagScore{}
+	}
+	for tag, counts := range e.Confusion {
+		score := TagScore{}
+		for _, count := range counts {
+			score.Support += count
+		}
+		score.Precision = ratio(counts[tag], predicted[tag])
+		score.Recall = ratio(counts[tag], score.Support)
+		if score.Precision+score.Recall > 0 {
+			score.F1 = 2 * score.Precision * score.Recall /
+				(score.Precision + score.Recall)
+		}
+		e.Tags[tag] = score
+	}
+
+	return &e
+}
+
+// CrossValidate performs k-fold cross-validation: sentences are shuffled and
+// split into k folds, and each fold is evaluated using a new tagger trained
+// (for the given number of iterations) on the other folds. The sentences are
+// shuffled by a random source with the given seed, so the results are
+// reproducible.
+//
+// The Evaluation of the ith fold is at index i of the returned slice.
+func CrossValidate(sentences TupleSlice, k, iterations int, seed int64) ([]*Evaluation, error) {
+	if k < 2 || k > len(sentences) {
+		return nil, errors.New("k must be between 2 and the number of sentences")
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	shuffled := make(TupleSlice, len(sentences))
+	for i, j := range r.Perm(len(sentences)) {
+		shuffled[i] = sentences[j]
+	}
+
+	evaluations := []*Evaluation{}
+	for fold := 0; fold < k; fold++ {
+		train, test := TupleSlice{}, TupleSlice{}
+		for i, tuple := range shuffled {
+			if i%k == fold {
+				test = append(test, tuple)
+			} else {
+				train = append(train, tuple)
+			}
+		}
+
+		tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+			make(map[string]map[string]float64), make(map[string]string),
+			[]string{}))
+		tagger.Rand = rand.New(rand.NewSource(seed))
+		tagger.Train(train, iterations)
+
+		evaluations = append(evaluations, Evaluate(tagger, test))
+	}
+
+	return evaluations, nil
+}
+
+// isKnown determines if word was seen while training the tagger's model.
+func (pt *PerceptronTagger) isKnown(word string) bool {
+	if _, found := pt.model.tagMap[word]; found {
+		return true
+	}
+	_, found := pt.model.weights["i word "+normalize(word)]
+	return found
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
@@ -0,0 +1,95 @@
+package tag
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+		make(map[string]map[string]float64),
+		map[string]string{"the": "DT", "dog": "NN"}, []string{"DT", "NN"}))
+
+	e := Evaluate(tagger, ReadTagged("the|DT dog|NN barks|VBZ", "|"))
+	assert.Equal(t, 3, e.Tokens)
+	assert.Equal(t, 2, e.Known)
+	assert.Equal(t, 1, e.Unknown)
+	assert.InDelta(t, 2.0/3.0, e.Accuracy, 1e-9)
+	assert.Equal(t, 1.0, e.KnownAccuracy)
+	assert.Equal(t, 0.0, e.UnknownAccuracy)
+
+	assert.Equal(t, map[string]map[string]int{
+		"DT": {"DT": 1}, "NN": {"NN": 1}, "VBZ": {"DT": 1}}, e.Confusion)
+	assert.Equal(t, map[string]TagScore{
+		"DT":  {Precision: 0.5, Recall: 1, F1: 2.0 / 3.0, Support: 1},
+		"NN":  {Precision: 1, Recall: 1, F1: 1, Support: 1},
+		"VBZ": {Support: 1},
+	}, e.Tags)
+}
+
+func TestEvaluateModel(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+	e := Evaluate(tagger, ReadTagged(wsj, "|"))
+
+	support := 0
+	for _, score := range e.Tags {
+		support += score.Support
+	}
+	assert.Equal(t, e.Tokens, support)
+	assert.Equal(t, e.Tokens, e.Known+e.Unknown)
+	assert.True(t, e.Accuracy > 0.9)
+	assert.True(t, e.Tags["NNP"].F1 > 0.9)
+}
+
+func TestCrossValidate(t *testing.T) {
+	sentences := ReadTagged(wsj, "|")
+
+	first, err := CrossValidate(sentences, 3, 5, 42)
+	util.CheckError(err)
+	second, err := CrossValidate(sentences, 3, 5, 42)
+	util.CheckError(err)
+
+	assert.Len(t, first, 3)
+	assert.Equal(t, first, second)
+
+	tokens, expected := 0, 0
+	for i, e := range first {
+		tokens += e.Tokens
+		expected += len(sentences[i][0])
+	}
+	assert.Equal(t, expected, tokens)
+
+	_, err = CrossValidate(sentences, 4, 5, 42)
+	assert.NotNil(t, err)
+}
+
+func TestTrainReproducible(t *testing.T) {
+	weights := []map[string]map[string]float64{}
+	for i := 0; i < 2; i++ {
+		tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+			make(map[string]map[string]float64), make(map[string]string),
+			[]string{}))
+		tagger.Rand = rand.New(rand.NewSource(1))
+		tagger.Train(ReadTagged(wsj, "|"), 10)
+		weights = append(weights, tagger.Weights())
+	}
+	assert.Equal(t, weights[0], weights[1])
+}
+
+func TestTrainNoEmptyClass(t *testing.T) {
+	tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+		make(map[string]map[string]float64), make(map[string]string),
+		[]string{}))
+	tagger.Rand = rand.New(rand.NewSource(1))
+	tagger.Train(ReadTagged(wsj, "|"), 5)
+
+	for feat, weights := range tagger.Weights() {
+		if _, found := weights[""]; found {
+			t.Errorf("feature %q has a weight for the empty class", feat)
+		}
+	}
+	assert.NotContains(t, tagger.Classes(), "")
+}
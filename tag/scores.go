@@ -0,0 +1,115 @@
+package tag
+
+import (
+	"math"
+	"sort"
+)
+
+// A TagSource describes how a PerceptronTagger chose a token's tag.
+type TagSource int
+
+const (
+	// SourceModel means the tag was predicted by the AveragedPerceptron.
+	SourceModel TagSource = iota
+
+	// SourceTagMap means the word was found in the model's tag map (i.e., it
+	// almost always had the same tag in the training data).
+	SourceTagMap
+
+	// SourcePattern means the word is a placeholder (e.g., "*-1" or "-LRB-")
+	// whose tag is determined by its form.
+	SourcePattern
+)
+
+// A ScoredTag is a tag along with its probability, as estimated by the
+// softmax of the model's scores.
+type ScoredTag struct {
+	Tag   string
+	Score float64
+}
+
+// A ScoredToken is a tagged token along with the most likely tags for it and
+// where its tag came from.
+//
+// Tags are sorted from most to least likely. A tag that didn't come from the
+// model is certain, so its Tags contain only that tag (with a Score of 1).
+type ScoredToken struct {
+	Token
+	Tags   []ScoredTag
+	Source TagSource
+}
+
+// TagWithScores is like Tag, but it also returns the (up to) k most likely
+// tags for each token--or all of them, if k < 1.
+//
+// Each token's Tag is the same tag that Tag would assign it, and its Tags are
+// scored given the tags of the two words before it. So, Tag is the first of
+// Tags unless pt.BeamWidth is greater than 1.
+func (pt *PerceptronTagger) TagWithScores(words []string, k int) []ScoredToken {
+	var tokens []ScoredToken
+	pt.decode(words, func(word, tag string, src TagSource, scores map[string]float64) {
+		tok := ScoredToken{Token: Token{Tag: tag, Text: word}, Source: src}
+		if src == SourceModel {
+			tok.Tags = pt.model.softmax(scores)
+		} else {
+			tok.Tags = []ScoredTag{{Tag: tag, Score: 1}}
+		}
+		if k > 0 && len(tok.Tags) > k {
+			tok.Tags = tok.Tags[:k]
+		}
+		tokens = append(tokens, tok)
+	})
+	return tokens
+}
+
+// softmax converts scores (in which missing classes have a score of 0) into a
+// probability for every class, sorted from most to least likely.
+func (ap *AveragedPerceptron) softmax(scores map[string]float64) []ScoredTag {
+	tags := ap.logSoftmax(scores)
+	for i := range tags {
+		tags[i].Score = math.Exp(tags[i].Score)
+	}
+	return tags
+}
+
+// logSoftmax is like softmax, but it returns log-probabilities.
+func (ap *AveragedPerceptron) logSoftmax(scores map[string]float64) []ScoredTag {
+	tags := []ScoredTag{}
+	for _, class := range ap.classes {
+		if _, found := scores[class]; !found {
+			tags = append(tags, ScoredTag{Tag: class})
+		}
+	}
+	for class, score := range scores {
+		tags = append(tags, ScoredTag{Tag: class, Score: score})
+	}
+	if len(tags) == 0 {
+		return tags
+	}
+
+	// Sorting first also fixes the order of the sum (which map iteration
+	// wouldn't), since the ranking doesn't change.
+	sort.Sort(byScore(tags))
+	max, total := tags[0].Score, 0.0
+	for _, t := range tags {
+		total += math.Exp(t.Score - max)
+	}
+	norm := max + math.Log(total)
+	for i := range tags {
+		tags[i].Score -= norm
+	}
+	return tags
+}
+
+// byScore sorts ScoredTags from highest to lowest score, breaking ties
+// alphabetically.
+type byScore []ScoredTag
+
+func (s byScore) Len() int      { return len(s) }
+func (s byScore) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byScore) Less(i, j int) bool {
+	if s[i].Score != s[j].Score {
+		return s[i].Score > s[j].Score
+	}
+	return s[i].Tag < s[j].Tag
+}
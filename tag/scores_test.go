@@ -0,0 +1,73 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagWithScores(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+	words := strings.Fields(
+		"Mr. Vinken is chairman of the flibbertigibbet group -LRB- sic -RRB- .")
+
+	tokens := tagger.TagWithScores(words, 0)
+	expected := tagger.Tag(words)
+	if !assert.Equal(t, len(expected), len(tokens)) {
+		return
+	}
+
+	sources := map[string]TagSource{}
+	for i, tok := range tokens {
+		assert.Equal(t, expected[i], tok.Token)
+		assert.Equal(t, tok.Tag, tok.Tags[0].Tag)
+		sources[tok.Text] = tok.Source
+
+		total := 0.0
+		for j, scored := range tok.Tags {
+			if j > 0 {
+				assert.True(t, scored.Score <= tok.Tags[j-1].Score)
+			}
+			total += scored.Score
+		}
+		assert.InDelta(t, 1.0, total, 1e-9)
+
+		if tok.Source == SourceModel {
+			assert.Equal(t, len(tagger.Classes()), len(tok.Tags))
+		} else {
+			assert.Equal(t, []ScoredTag{{Tag: tok.Tag, Score: 1}}, tok.Tags)
+		}
+	}
+
+	assert.Equal(t, SourceTagMap, sources["the"])
+	assert.Equal(t, SourceModel, sources["flibbertigibbet"])
+	assert.Equal(t, SourcePattern, sources["-LRB-"])
+}
+
+func TestTagWithScoresTopK(t *testing.T) {
+	tagger := MustNewPerceptronTagger()
+	words := strings.Fields("The flibbertigibbet jumped over the fence .")
+
+	all := tagger.TagWithScores(words, 0)
+	for i, tok := range tagger.TagWithScores(words, 3) {
+		assert.True(t, len(tok.Tags) <= 3)
+		for j, scored := range tok.Tags {
+			assert.Equal(t, all[i].Tags[j].Tag, scored.Tag)
+			assert.InDelta(t, all[i].Tags[j].Score, scored.Score, 1e-9)
+		}
+	}
+}
+
+func TestTagWithScoresUnseen(t *testing.T) {
+	// None of the features of "blorp" have weights, so every class has a
+	// score of 0.
+	tagger := NewTrainedPerceptronTagger(NewAveragedPerceptron(
+		make(map[string]map[string]float64), make(map[string]string),
+		[]string{"VB", "NN"}))
+
+	tokens := tagger.TagWithScores([]string{"blorp"}, 0)
+	assert.Equal(t, tagger.Tag([]string{"blorp"}), []Token{tokens[0].Token})
+	assert.Equal(t, "NN", tokens[0].Tag)
+	assert.Equal(t, []ScoredTag{{"NN", 0.5}, {"VB", 0.5}}, tokens[0].Tags)
+}
@@ -0,0 +1,192 @@
+package tokenize
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"unicode/utf8"
+
+	"github.com/jdkato/prose/internal/util"
+)
+
+// ErrNoMatch is the kind of error returned by Lexer.Lex when part of its
+// input doesn't match any rule.
+var ErrNoMatch = errors.New("no matching rule")
+
+// A LexerRule is a named pattern used by a Lexer.
+type LexerRule struct {
+	Name    string // the Type of the tokens matched by this rule
+	Pattern string // a regular expression (see the regexp package)
+	Skip    bool   // if true, matches are discarded (e.g., for whitespace)
+}
+
+// A Lexeme is a token found by a Lexer along with its type (i.e., the name of
+// the rule it matched).
+type Lexeme struct {
+	Span
+	Type string
+}
+
+// Lexer splits text into typed tokens using an ordered list of rules.
+//
+// At each position in its input, a Lexer tries every rule and takes the
+// longest match; ties go to the rule listed first. For example, given the
+// rules
+//
+//    []LexerRule{
+//        {Name: "NUMBER", Pattern: `\d+(\.\d+)?`},
+//        {Name: "EMAIL", Pattern: `[\w.+-]+@[\w-]+(\.[\w-]+)+`},
+//        {Name: "WORD", Pattern: `\w+`},
+//        {Name: "PUNCT", Pattern: `[^\w\s]`},
+//        {Name: "SPACE", Pattern: `\s+`, Skip: true},
+//    }
+//
+// "2024" is a NUMBER (rather than a WORD) and "a.b@c.org" is a single EMAIL.
+//
+// Since each rule is matched against the text that remains at the current
+// position, patterns can't contain assertions that depend on the text before
+// it (i.e., `^`, `\A`, `\b`, or `\B`).
+type Lexer struct {
+	// Unmatched is the Type given to text that doesn't match any rule; each
+	// run of such text becomes a single token. If Unmatched is empty, Lex
+	// returns an error instead (and Tokenize discards the text).
+	Unmatched string
+
+	rules []lexerRule
+}
+
+type lexerRule struct {
+	name  string
+	regex *regexp.Regexp
+	skip  bool
+}
+
+// NewLexer creates a new Lexer from rules, which are tried in order.
+//
+// If a rule's pattern can't be compiled (or contains an unsupported
+// assertion), the returned error is of the kind ErrBadPattern.
+func NewLexer(rules []LexerRule) (*Lexer, error) {
+	l := Lexer{}
+	for _, rule := range rules {
+		// Parse the pattern by itself first, so that errors refer to it
+		// (rather than to our anchored version of it).
+		re, err := syntax.Parse(rule.Pattern, syntax.Perl)
+		if err != nil {
+			return nil, util.NewError(ErrBadPattern, fmt.Errorf("%s: %v", rule.Name, err))
+		} else if lookbehind(re) {
+			return nil, util.NewError(ErrBadPattern, fmt.Errorf(
+				"%s: unsupported assertion (^, \\A, \\b, or \\B): `%s`", rule.Name, rule.Pattern))
+		}
+		regex := regexp.MustCompile(`^(?:` + rule.Pattern + `)`)
+		regex.Longest()
+		l.rules = append(l.rules, lexerRule{name: rule.Name, regex: regex, skip: rule.Skip})
+	}
+	return &l, nil
+}
+
+// MustNewLexer is like NewLexer, but it panics if a rule's pattern can't be
+// compiled.
+func MustNewLexer(rules []LexerRule) *Lexer {
+	l, err := NewLexer(rules)
+	util.CheckError(err)
+	return l
+}
+
+// Lex splits text into Lexemes.
+//
+// If part of text doesn't match any rule and l.Unmatched is empty, the
+// Lexemes found before it are returned along with an error of the kind
+// ErrNoMatch.
+func (l Lexer) Lex(text string) ([]Lexeme, error) {
+	return l.lex(text, true)
+}
+
+// Tokenize splits text into a slice of tokens, discarding any text that
+// doesn't match a rule (unless l.Unmatched is set).
+func (l Lexer) Tokenize(text string) []string {
+	lexemes, _ := l.lex(text, false)
+	tokens := make([]string, len(lexemes))
+	for i, lex := range lexemes {
+		tokens[i] = lex.Text
+	}
+	return tokens
+}
+
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// token in text.
+func (l Lexer) TokenizeSpans(text string) []Span {
+	lexemes, _ := l.lex(text, false)
+	spans := make([]Span, len(lexemes))
+	for i, lex := range lexemes {
+		spans[i] = lex.Span
+	}
+	return spans
+}
+
+// lookbehind determines if re contains an assertion that depends on the text
+// before the position it's matched at.
+func lookbehind(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpBeginText, syntax.OpWordBoundary,
+		syntax.OpNoWordBoundary:
+		return true
+	}
+	for _, sub := range re.Sub {
+		if lookbehind(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+// lex splits text into Lexemes. If strict is true (and l.Unmatched is empty),
+// it stops at the first unmatched text.
+func (l Lexer) lex(text string, strict bool) ([]Lexeme, error) {
+	lexemes := []Lexeme{}
+	add := func(typ string, start, end, runeStart int) {
+		lexemes = append(lexemes, Lexeme{Type: typ, Span: Span{
+			Text: text[start:end], Start: start, End: end, RuneStart: runeStart,
+			RuneEnd: runeStart + utf8.RuneCountInString(text[start:end])}})
+	}
+
+	pos, runes := 0, 0
+	unmatched, unmatchedRunes := -1, 0 // the start of the current unmatched text
+	for pos < len(text) {
+		best, size := -1, 0
+		for i, rule := range l.rules {
+			if loc := rule.regex.FindStringIndex(text[pos:]); loc != nil && loc[1] > size {
+				best, size = i, loc[1]
+			}
+		}
+
+		if best < 0 {
+			r, n := utf8.DecodeRuneInString(text[pos:])
+			if strict && l.Unmatched == "" {
+				return lexemes, util.NewError(
+					ErrNoMatch, fmt.Errorf("%q at byte %d", r, pos))
+			} else if unmatched < 0 {
+				unmatched, unmatchedRunes = pos, runes
+			}
+			pos += n
+			runes++
+			continue
+		}
+
+		if unmatched >= 0 && l.Unmatched != "" {
+			add(l.Unmatched, unmatched, pos, unmatchedRunes)
+		}
+		unmatched = -1
+
+		if !l.rules[best].skip {
+			add(l.rules[best].name, pos, pos+size, runes)
+		}
+		runes += utf8.RuneCountInString(text[pos : pos+size])
+		pos += size
+	}
+	if unmatched >= 0 && l.Unmatched != "" {
+		add(l.Unmatched, unmatched, pos, unmatchedRunes)
+	}
+
+	return lexemes, nil
+}
@@ -0,0 +1,127 @@
+package tokenize
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var lexerRules = []LexerRule{
+	{Name: "NUMBER", Pattern: `\d+(\.\d+)?`},
+	{Name: "EMAIL", Pattern: `[\w.+-]+@[\w-]+(\.[\w-]+)+`},
+	{Name: "WORD", Pattern: `[\pL\pN_]+`},
+	{Name: "PUNCT", Pattern: `[.,!?;:]`},
+	{Name: "SPACE", Pattern: `\s+`, Skip: true},
+}
+
+func ExampleLexer() {
+	l := MustNewLexer(lexerRules)
+	lexemes, _ := l.Lex("Email jane.doe@example.com by 5.30!")
+	for _, lex := range lexemes {
+		fmt.Println(lex.Type, lex.Text)
+	}
+	// Output:
+	// WORD Email
+	// EMAIL jane.doe@example.com
+	// WORD by
+	// NUMBER 5.30
+	// PUNCT !
+}
+
+func TestLexerLongestMatch(t *testing.T) {
+	l := MustNewLexer([]LexerRule{
+		{Name: "IF", Pattern: `if`},
+		{Name: "IDENT", Pattern: `[a-z]+`},
+		{Name: "OP", Pattern: `=|==`},
+		{Name: "SPACE", Pattern: ` `, Skip: true},
+	})
+
+	lexemes, err := l.Lex("if iffy == if")
+	assert.Nil(t, err)
+
+	types := []string{}
+	for _, lex := range lexemes {
+		types = append(types, lex.Type+":"+lex.Text)
+	}
+	assert.Equal(t, []string{"IF:if", "IDENT:iffy", "OP:==", "IF:if"}, types)
+}
+
+func TestLexerUnmatched(t *testing.T) {
+	l := MustNewLexer(lexerRules)
+
+	lexemes, err := l.Lex("café #1 ok")
+	assert.True(t, IsKind(err, ErrNoMatch))
+	assert.EqualError(t, err, "no matching rule: '#' at byte 6")
+	assert.Len(t, lexemes, 1)
+	assert.Equal(t, []string{"café", "1", "ok"}, l.Tokenize("café #1 ok"))
+
+	l.Unmatched = "OTHER"
+	lexemes, err = l.Lex("café ##1 ok")
+	assert.Nil(t, err)
+	assert.Equal(t, []Lexeme{
+		{Span{Text: "café", Start: 0, End: 5, RuneStart: 0, RuneEnd: 4}, "WORD"},
+		{Span{Text: "##", Start: 6, End: 8, RuneStart: 5, RuneEnd: 7}, "OTHER"},
+		{Span{Text: "1", Start: 8, End: 9, RuneStart: 7, RuneEnd: 8}, "NUMBER"},
+		{Span{Text: "ok", Start: 10, End: 12, RuneStart: 9, RuneEnd: 11}, "WORD"},
+	}, lexemes)
+	assert.Equal(t, []string{"café", "##", "1", "ok"}, l.Tokenize("café ##1 ok"))
+}
+
+func TestLexerSpans(t *testing.T) {
+	l := MustNewLexer(lexerRules)
+	text := "Mr. Smith paid $3.50, didn’t he?"
+	for _, span := range l.TokenizeSpans(text) {
+		assert.Equal(t, span.Text, text[span.Start:span.End])
+		assert.Equal(t, span.Text,
+			string([]rune(text)[span.RuneStart:span.RuneEnd]))
+	}
+}
+
+func TestLexerFromJSON(t *testing.T) {
+	config := `[
+		{"name": "HASHTAG", "pattern": "#\\w+"},
+		{"name": "WORD", "pattern": "\\w+"},
+		{"name": "SPACE", "pattern": "\\s+", "skip": true}
+	]`
+	rules := []LexerRule{}
+	util.CheckError(json.Unmarshal([]byte(config), &rules))
+
+	l := MustNewLexer(rules)
+	lexemes, err := l.Lex("loving #golang")
+	assert.Nil(t, err)
+	assert.Equal(t, "HASHTAG", lexemes[1].Type)
+}
+
+func TestNewLexerError(t *testing.T) {
+	l, err := NewLexer([]LexerRule{{Name: "WORD", Pattern: `[a-z`}})
+	assert.Nil(t, l)
+	assert.True(t, IsKind(err, ErrBadPattern))
+	assert.EqualError(t, err,
+		"bad pattern: WORD: error parsing regexp: missing closing ]: `[a-z`")
+
+	assert.Panics(t, func() { MustNewLexer([]LexerRule{{Pattern: `(`}}) })
+
+	// A pattern that's only invalid once wrapped is still reported as-is.
+	_, err = NewLexer([]LexerRule{{Name: "P", Pattern: `a)|(b`}})
+	assert.EqualError(t, err, "bad pattern: P: error parsing regexp: unexpected ): `a)|(b`")
+}
+
+func TestLexerAssertions(t *testing.T) {
+	for _, pattern := range []string{`^ab`, `(?m)^ab`, `\Aab`, `\bab`, `a(\Bb)+`} {
+		_, err := NewLexer([]LexerRule{{Name: "AB", Pattern: pattern}})
+		assert.True(t, IsKind(err, ErrBadPattern), pattern)
+	}
+	_, err := NewLexer([]LexerRule{{Name: "AB", Pattern: `\bab`}})
+	assert.EqualError(t, err,
+		"bad pattern: AB: unsupported assertion (^, \\A, \\b, or \\B): `\\bab`")
+
+	// Assertions about the text that follows are fine.
+	l := MustNewLexer([]LexerRule{{Name: "AB", Pattern: `ab$`}, {Name: "CHAR", Pattern: `.`}})
+	lexemes, err := l.Lex("abab")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"CHAR", "CHAR", "AB"}, []string{
+		lexemes[0].Type, lexemes[1].Type, lexemes[2].Type})
+}
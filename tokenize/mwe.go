@@ -0,0 +1,137 @@
+package tokenize
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// MWETokenizer merges multi-word expressions (e.g., "New York", "ad hoc", or
+// "in spite of") in the output of another tokenizer into single tokens.
+//
+// Expressions are matched, case-sensitively, against runs of tokens; where
+// expressions overlap, the longest one starting at the earliest token wins.
+// The words of a merged expression are separated by Joiner.
+//
+// This implementation is a port of NLTK's MWETokenizer.
+type MWETokenizer struct {
+	Joiner string // separates the words of a merged expression (defaults to "_")
+
+	tokenizer ProseTokenizer
+	lexicon   *mweTrie
+}
+
+// A mweTrie stores multi-word expressions, one word per level.
+type mweTrie struct {
+	children map[string]*mweTrie
+	end      bool // an expression ends here
+}
+
+func newMWETrie() *mweTrie {
+	return &mweTrie{children: make(map[string]*mweTrie)}
+}
+
+// NewMWETokenizer creates a new MWETokenizer that merges the expressions in
+// the output of t. Use Add or Load to populate its lexicon.
+func NewMWETokenizer(t ProseTokenizer) *MWETokenizer {
+	return &MWETokenizer{Joiner: "_", tokenizer: t, lexicon: newMWETrie()}
+}
+
+// Add adds an expression, given as a sequence of tokens (e.g., "in", "spite",
+// "of"), to the lexicon.
+func (m *MWETokenizer) Add(words ...string) {
+	if len(words) == 0 {
+		return
+	}
+	node := m.lexicon
+	for _, word := range words {
+		child, found := node.children[word]
+		if !found {
+			child = newMWETrie()
+			node.children[word] = child
+		}
+		node = child
+	}
+	node.end = true
+}
+
+// Load reads a lexicon from r, with one expression per line, and adds it to
+// the MWETokenizer's lexicon. Each expression is split into words by the
+// wrapped tokenizer; blank lines and lines starting with "#" are ignored.
+func (m *MWETokenizer) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			m.Add(m.tokenizer.Tokenize(line)...)
+		}
+	}
+	return scanner.Err()
+}
+
+// Tokenize splits text into a slice of tokens, merging any multi-word
+// expressions.
+func (m MWETokenizer) Tokenize(text string) []string {
+	tokens := []string{}
+	for _, span := range m.merge(m.tokenizer.Tokenize(text), nil) {
+		tokens = append(tokens, span.Text)
+	}
+	return tokens
+}
+
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// token in text. A merged expression's Span covers all of its words (and the
+// text between them).
+func (m MWETokenizer) TokenizeSpans(text string) []Span {
+	var spans []Span
+	if t, ok := m.tokenizer.(SpanTokenizer); ok {
+		spans = t.TokenizeSpans(text)
+	} else {
+		spans = alignSpans(text, m.tokenizer.Tokenize(text))
+	}
+
+	words := make([]string, len(spans))
+	for i, span := range spans {
+		words[i] = span.Text
+	}
+	return m.merge(words, spans)
+}
+
+// merge replaces each expression in words with a single token. If spans (the
+// locations of words) is nil, the returned Spans only contain the tokens.
+func (m MWETokenizer) merge(words []string, spans []Span) []Span {
+	merged := []Span{}
+	for i := 0; i < len(words); {
+		n := m.match(words[i:])
+		if n == 0 {
+			n = 1
+		}
+
+		span := Span{Text: strings.Join(words[i:i+n], m.Joiner)}
+		if spans != nil {
+			span.Start, span.End = spans[i].Start, spans[i+n-1].End
+			span.RuneStart, span.RuneEnd = spans[i].RuneStart, spans[i+n-1].RuneEnd
+		}
+		merged = append(merged, span)
+		i += n
+	}
+	return merged
+}
+
+// match returns the number of words in the longest expression at the start
+// of words (or 0 if there isn't one).
+func (m MWETokenizer) match(words []string) int {
+	longest := 0
+	node := m.lexicon
+	for i, word := range words {
+		child, found := node.children[word]
+		if !found {
+			break
+		}
+		node = child
+		if node.end {
+			longest = i + 1
+		}
+	}
+	return longest
+}
@@ -0,0 +1,63 @@
+package tokenize
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleNewMWETokenizer() {
+	t := NewMWETokenizer(NewTreebankWordTokenizer())
+	t.Add("New", "York")
+	t.Add("in", "spite", "of")
+	fmt.Println(t.Tokenize("In New York, in spite of the rain."))
+	// Output: [In New_York , in_spite_of the rain .]
+}
+
+func TestMWETokenizer(t *testing.T) {
+	tok := NewMWETokenizer(NewTreebankWordTokenizer())
+	util.CheckError(tok.Load(strings.NewReader(
+		"# Place names\nNew York\nNew York City\n\nad hoc\n  a priori  \n")))
+
+	tests := []struct {
+		text   string
+		tokens []string
+	}{
+		{"New York City is big.",
+			[]string{"New_York_City", "is", "big", "."}},
+		{"New York is big; New Jersey isn't.",
+			[]string{"New_York", "is", "big", ";", "New", "Jersey", "is", "n't", "."}},
+		{"An ad hoc, a priori argument about new york.",
+			[]string{"An", "ad_hoc", ",", "a_priori", "argument", "about", "new",
+				"york", "."}},
+		{"New", []string{"New"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.tokens, tok.Tokenize(test.text))
+	}
+
+	tok.Joiner = " "
+	assert.Equal(t, []string{"New York", "."}, tok.Tokenize("New York."))
+}
+
+func TestMWETokenizerSpans(t *testing.T) {
+	text := "They met in \"New  York\"."
+	for _, inner := range []ProseTokenizer{
+		NewTreebankWordTokenizer(), MustNewRegexpTokenizer(`\w+|[^\w\s]+`, false, false),
+		struct{ ProseTokenizer }{NewTreebankWordTokenizer()}} {
+		tok := NewMWETokenizer(inner)
+		tok.Add("New", "York")
+		tok.Joiner = ""
+
+		spans := tok.TokenizeSpans(text)
+		if assert.Equal(t, tok.Tokenize(text)[4], spans[4].Text) {
+			assert.Equal(t, "NewYork", spans[4].Text)
+			assert.Equal(t, "New  York", text[spans[4].Start:spans[4].End])
+			assert.Equal(t, "New  York",
+				string([]rune(text)[spans[4].RuneStart:spans[4].RuneEnd]))
+		}
+	}
+}
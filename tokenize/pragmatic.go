@@ -24,10 +24,15 @@
 package tokenize
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/jdkato/prose/internal/util"
 )
@@ -39,15 +44,41 @@ import (
 // This is a port of the Ruby library by Kevin S. Dias
 // (https://github.com/diasks2/pragmatic_segmenter).
 type PragmaticSegmenter struct {
+	// Lists, if true, makes each item of a numbered, alphabetical, or
+	// roman-numeral list (e.g., "1.", "a)", or "(iv)") its own sentence--even
+	// if it lacks terminal punctuation.
+	Lists bool
+
+	// DocType describes how text is formatted; see PlainText and PDF.
+	DocType DocType
+
 	processor languageProcessor
 }
 
+// A DocType describes the formatting of the text given to a
+// PragmaticSegmenter.
+type DocType int
+
+const (
+	// PlainText is text in which every line break ends a sentence. This is the
+	// default.
+	PlainText DocType = iota
+
+	// PDF is hard-wrapped text (e.g., text extracted from a PDF or an email)
+	// in which line breaks can occur in the middle of a sentence. Wrapped
+	// lines are joined--along with any words hyphenated across them--and only
+	// blank lines (i.e., paragraph breaks) and bullets ("•") are treated as
+	// sentence boundaries.
+	PDF
+)
+
 // NewPragmaticSegmenter creates a new PragmaticSegmenter according to the
 // specified language. If the given language is not supported, an error will be
 // returned.
 //
 // Languages are specified by their two-character ISO 639-1 code. The supported
-// languages are "en" (English), "es" (Spanish), "fr" (French) ... (WIP)
+// languages are "en" (English), "es" (Spanish), "fr" (French), "de" (German),
+// "zh" (Chinese), and "ja" (Japanese) ... (WIP)
 func NewPragmaticSegmenter(lang string) (*PragmaticSegmenter, error) {
 	if p, ok := langToProcessor[lang]; ok {
 		return &PragmaticSegmenter{processor: p}, nil
@@ -57,11 +88,59 @@ func NewPragmaticSegmenter(lang string) (*PragmaticSegmenter, error) {
 
 // Tokenize splits text into sentences.
 func (p *PragmaticSegmenter) Tokenize(text string) []string {
-	return p.processor.process(text)
+	return p.processor.process(text, p.options())
+}
+
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// sentence in text.
+func (p *PragmaticSegmenter) TokenizeSpans(text string) []Span {
+	return alignSpans(text, p.Tokenize(text))
+}
+
+// SplitParagraphs splits text into paragraphs, which are separated by "\n\n"
+// or--for PDF documents--by any blank line (which may contain whitespace).
+func (p *PragmaticSegmenter) SplitParagraphs(text string) []string {
+	if p.DocType == PDF {
+		return paragraphBreakRE.Split(text, -1)
+	}
+	return strings.Split(text, "\n\n")
+}
+
+// isBoundary determines if text can be segmented in two pieces at i.
+//
+// Since some of the segmenter's rules (e.g., those for quotations) can span
+// multiple sentences on the same line, this is only true at the start of a
+// (possibly indented) line--or, for PDF documents, at the start of a
+// paragraph.
+func (p *PragmaticSegmenter) isBoundary(text string, i int) bool {
+	if p.DocType == PDF {
+		newlines := 0
+		for j := i - 1; j >= 0 && strings.IndexByte(" \t\r\n", text[j]) >= 0; j-- {
+			if text[j] == '\n' {
+				newlines++
+			}
+		}
+		return newlines > 1
+	}
+	for i > 0 && (text[i-1] == ' ' || text[i-1] == '\t') {
+		i--
+	}
+	return i > 0 && text[i-1] == '\n'
+}
+
+func (p *PragmaticSegmenter) options() segmenterOptions {
+	return segmenterOptions{lists: p.Lists, docType: p.DocType}
 }
 
 /* Helper functions, regexps, and types */
 
+// segmenterOptions holds the settings of a PragmaticSegmenter, which are
+// passed along to its (shared) languageProcessor.
+type segmenterOptions struct {
+	lists   bool
+	docType DocType
+}
+
 // A rule associates a regular expression with a replacement string.
 type rule struct {
 	pattern     *regexp.Regexp
@@ -109,6 +188,7 @@ var allNumberRules = []rule{
 
 // common
 
+var paragraphBreakRE = regexp.MustCompile(`\n\s*\n`)
 var cleanRules = []rule{
 	{pattern: regexp.MustCompile(`[^\n]\s(\n)\S`), replacement: ""},
 	{pattern: regexp.MustCompile(`(\n)[a-z]`), replacement: " "},
@@ -123,7 +203,7 @@ var sentenceBoundaryRE = regexp.MustCompile(
 		`'(?:[^'])*[^,]'(\s[A-Z])|` +
 		`"(?:[^"])*[^,]"(\s[A-Z])|` +
 		`“(?:[^”])*[^,]”(\s[A-Z])|` +
-		`\S.*?[。．.！!?？ȸȹ☉☈☇☄]`)
+		`\S.*?[。．.！!?？ȸȹ☉☈☇☄]+`)
 var quotationAtEndOfSentenceRE = regexp.MustCompile(
 	`[!?\.-][\"\'\x{201d}\x{201c}]\s{1}[A-Z]`)
 var splitSpaceQuotationAtEndOfSentenceRE = regexp.MustCompile(
@@ -192,10 +272,13 @@ func subPat(text, mtype string, pat *regexp.Regexp) string {
 	return r.replace()
 }
 
-// replaceBetweenQuotes replaces punctuation inside quotes.
-func replaceBetweenQuotes(text string) string {
+// replaceBetweenQuotes replaces punctuation inside quotes, using the regexps
+// in double to find double quotations.
+func replaceBetweenQuotes(text string, double []*regexp.Regexp) string {
 	text = subPat(text, "single", betweenSingleQuotesRE)
-	text = subPat(text, "double", betweenDoubleQuotesRE)
+	for _, pat := range double {
+		text = subPat(text, "double", pat)
+	}
 	text = subPat(text, "double", betweenSquareBracketsRE)
 	text = subPat(text, "double", betweenParensRE)
 	text = subPat(text, "double", betweenArrowQuotesRE)
@@ -254,7 +337,7 @@ func (r *punctuationReplacer) replacePunctuation(matches []string) string {
 		sub3 := r.sub(sub2, "！", "&ᓳ&")
 		sub4 := r.sub(sub3, "!", "&ᓴ&")
 		sub5 := r.sub(sub4, "?", "&ᓷ&")
-		sub6 := r.sub(sub5, "? ", "&ᓸ&")
+		sub6 := r.sub(sub5, "？", "&ᓸ&")
 		if r.matchType != "single" {
 			r.sub(sub6, "'", "&⎋&")
 		}
@@ -268,11 +351,248 @@ func (r *punctuationReplacer) sub(content, a, b string) string {
 	return repl
 }
 
+/* list_item_replacer */
+
+var romanNumerals = []string{
+	"i", "ii", "iii", "iv", "v", "vi", "vii", "viii", "ix", "x", "xi", "xii",
+	"xiii", "xiv", "xv", "xvi", "xvii", "xviii", "xix", "xx"}
+var latinNumerals = strings.Split("abcdefghijklmnopqrstuvwxyz", "")
+
+var numberedListRE = regexp.MustCompile(`\d{1,2}\.[\s)]`)
+var numberedListParensRE = regexp.MustCompile(`\d{1,2}\)\s`)
+var alphabeticalListRE = regexp.MustCompile(`(?i)[a-z]{1,5}\.`)
+var alphabeticalListParensRE = regexp.MustCompile(`(?i)\(?[a-z]+\)`)
+var romanNumeralsInParensRE = regexp.MustCompile(
+	`\((m*(?:c[md]|d?c*)(?:x[cl]|l?x*)(?:i[xv]|v?i*))\)\s[A-Z]`)
+
+var multiLineNumberedListRE = regexp.MustCompile(`♨.+[\n\r].+♨`)
+var forNumberedListRE = regexp.MustCompile(`for\s\d{1,2}♨\s[a-z]`)
+var multiLineNumberedListParensRE = regexp.MustCompile(`☝.+[\n\r].+☝`)
+
+var spaceBetweenListItemsRules = []rule{
+	{pattern: regexp.MustCompile(`(?m)(?:\S\S|^)(\s)\S\s*\d{1,2}♨`), replacement: "\n"},
+	{pattern: regexp.MustCompile(`(?m)(?:\S\S|^)(\s)\d{1,2}♨`), replacement: "\n"},
+}
+var spaceBetweenListItemsParensRule = rule{
+	pattern: regexp.MustCompile(`(?m)(?:\S\S|^)(\s)\d{1,2}☝`), replacement: "\n"}
+
+// A listItem is an occurrence of a list marker (e.g., "1." or "(a)") in text.
+type listItem struct {
+	start, end int
+	value      string
+}
+
+// listItemReplacer separates the items of a list with line breaks and
+// protects the periods and parentheses in their markers.
+type listItemReplacer struct {
+	text string
+}
+
+func (r *listItemReplacer) replace() string {
+	r.formatAlphabeticalLists(latinNumerals)
+	r.formatAlphabeticalLists(romanNumerals)
+	r.formatNumberedList()
+	r.formatNumberedListWithParens()
+	r.text = romanNumeralsInParensRE.ReplaceAllStringFunc(r.text, func(s string) string {
+		return "&✂&" + strings.Replace(s[1:], ")", "&⌬&", 1)
+	})
+	return r.text
+}
+
+func (r *listItemReplacer) formatNumberedList() {
+	r.replaceNumberedItems(numberedListRE, "♨", true)
+	if strings.Contains(r.text, "♨") &&
+		!multiLineNumberedListRE.MatchString(r.text) &&
+		!forNumberedListRE.MatchString(r.text) {
+		r.text = applyRules(r.text, spaceBetweenListItemsRules)
+	}
+	r.text = substitute(r.text, "♨", "∯")
+}
+
+func (r *listItemReplacer) formatNumberedListWithParens() {
+	r.replaceNumberedItems(numberedListParensRE, "☝", false)
+	if strings.Contains(r.text, "☝") &&
+		!multiLineNumberedListParensRE.MatchString(r.text) {
+		r.text = spaceBetweenListItemsParensRule.sub(r.text)
+	}
+	r.text = substitute(r.text, "☝", "")
+}
+
+// replaceNumberedItems appends marker to each number that is part of a
+// sequence (e.g., "1.", "2.", "3."), replacing its period if period is true.
+func (r *listItemReplacer) replaceNumberedItems(re *regexp.Regexp, marker string, period bool) {
+	items := []listItem{}
+	for _, loc := range re.FindAllStringIndex(r.text, -1) {
+		end := loc[1] - 2
+		if period && !startsListItem(r.text, loc[0], true) {
+			continue
+		} else if period {
+			end++
+		}
+		items = append(items, listItem{loc[0], end, r.text[loc[0] : loc[1]-2]})
+	}
+
+	numbers := make([]int, len(items))
+	for i, item := range items {
+		numbers[i], _ = strconv.Atoi(item.value)
+	}
+
+	keep := map[string]bool{}
+	for i, n := range numbers {
+		prev, next := -1, -1
+		if i > 0 {
+			prev = numbers[i-1]
+		}
+		if i < len(numbers)-1 {
+			next = numbers[i+1]
+		}
+		if n+1 == next || (n-1 == prev && i > 0) || (n == 0 && prev == 9) ||
+			(n == 9 && next == 0) {
+			keep[items[i].value] = true
+		}
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, item := range items {
+		if keep[item.value] {
+			buf.WriteString(r.text[last:item.start])
+			buf.WriteString(item.value + marker)
+			last = item.end
+		}
+	}
+	r.text = buf.String() + r.text[last:]
+}
+
+func (r *listItemReplacer) formatAlphabeticalLists(alphabet []string) {
+	r.replaceAlphabeticalItems(alphabeticalListRE, alphabet, false)
+	r.replaceAlphabeticalItems(alphabeticalListParensRE, alphabet, true)
+}
+
+// replaceAlphabeticalItems puts each item of a list marked by consecutive
+// entries of alphabet (e.g., "a.", "b.", "c." or "(i)", "(ii)", "(iii)") on
+// its own line.
+func (r *listItemReplacer) replaceAlphabeticalItems(re *regexp.Regexp, alphabet []string, parens bool) {
+	items := []listItem{}
+	for _, loc := range re.FindAllStringIndex(r.text, -1) {
+		m := r.text[loc[0]:loc[1]]
+		if m[0] != '(' && !startsListItem(r.text, loc[0], false) {
+			continue
+		}
+		value := strings.ToLower(strings.Trim(m, "()."))
+		if indexOf(value, alphabet) >= 0 {
+			items = append(items, listItem{loc[0], loc[1], value})
+		}
+	}
+
+	keep := map[string]bool{}
+	for i, item := range items {
+		idx := indexOf(item.value, alphabet)
+		if idx < 0 {
+			continue
+		}
+		prev, next := -2, -2
+		if i > 0 {
+			prev = indexOf(items[i-1].value, alphabet)
+		}
+		if i < len(items)-1 {
+			next = indexOf(items[i+1].value, alphabet)
+		}
+		if (next >= 0 && next-idx == 1) || (prev >= 0 && (prev-idx == 1 || idx-prev == 1)) {
+			keep[item.value] = true
+		}
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, item := range items {
+		m := r.text[item.start:item.end]
+		if !keep[item.value] {
+			continue
+		}
+		buf.WriteString(r.text[last:item.start])
+		if item.start > 0 && r.text[item.start-1] != '\n' {
+			buf.WriteString("\n")
+		}
+		if parens && m[0] == '(' {
+			buf.WriteString("&✂&" + m[1:])
+		} else if parens {
+			buf.WriteString(m)
+		} else {
+			buf.WriteString(m[:len(m)-1] + "∯")
+		}
+		last = item.end
+	}
+	r.text = buf.String() + r.text[last:]
+}
+
+// startsListItem determines if a list marker can start at text[i]: i.e., at
+// the start of a line, after whitespace, or (if bullets is true) after a
+// "-" or "⁃" that is itself at the start of a line or after whitespace.
+func startsListItem(text string, i int, bullets bool) bool {
+	if i == 0 {
+		return true
+	}
+	r, size := utf8.DecodeLastRuneInString(text[:i])
+	if unicode.IsSpace(r) {
+		return true
+	} else if bullets && (r == '-' || r == '⁃') {
+		return startsListItem(text, i-size, false)
+	}
+	return false
+}
+
+// removePDFLineBreaks joins the hard-wrapped lines of each paragraph in text,
+// leaving a single line break between paragraphs.
+func removePDFLineBreaks(text string) string {
+	paragraphs := paragraphBreakRE.Split(strings.TrimSpace(text), -1)
+	for i, paragraph := range paragraphs {
+		lines := []string{}
+		for _, line := range strings.Split(paragraph, "\n") {
+			line = strings.TrimSpace(line)
+			last := len(lines) - 1
+			if last >= 0 && isHyphenated(lines[last], line) {
+				lines[last] = lines[last][:len(lines[last])-1] + line
+			} else if last >= 0 && !strings.HasPrefix(line, "•") {
+				lines[last] += " " + line
+			} else {
+				lines = append(lines, line)
+			}
+		}
+		paragraphs[i] = strings.Join(lines, "\n")
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// isHyphenated determines if a word has been hyphenated across the end of
+// line and the start of next (e.g., "seg-" and "mentation").
+func isHyphenated(line, next string) bool {
+	if !strings.HasSuffix(line, "-") {
+		return false
+	}
+	before, _ := utf8.DecodeLastRuneInString(line[:len(line)-1])
+	after, _ := utf8.DecodeRuneInString(next)
+	return unicode.IsLetter(before) && unicode.IsLower(after)
+}
+
+// indexOf returns the index of the first occurrence of s in slice, or -1.
+func indexOf(s string, slice []string) int {
+	for i, e := range slice {
+		if e == s {
+			return i
+		}
+	}
+	return -1
+}
+
 /* abbreviation_replacer */
 
+// An abbreviationReplacer is shared by every PragmaticSegmenter for its
+// language, so its caches are guarded by mu.
 type abbreviationReplacer struct {
 	definition       languageDefinition
 	boundaries       *rule
+	mu               sync.Mutex
 	prepositiveCache map[string][]rule
 	numberCache      map[string][]rule
 	periodCache      map[string][]rule
@@ -319,7 +639,7 @@ func newAbbreviationReplacer(lang string) *abbreviationReplacer {
 func (r *abbreviationReplacer) replace(text string) string {
 	text = possessiveAbbreviationRule.sub(text)
 	text = kommanditgesellschaftRule.sub(text)
-	text = applyRules(text, allSingleUpperCaseLetterRules)
+	text = applyRules(text, r.definition.singleLetterRules())
 
 	text = r.search(text, r.definition.abbreviations()["abbreviations"])
 	text = r.replaceMultiPeriods(text)
@@ -342,6 +662,7 @@ func (r *abbreviationReplacer) search(query string, list []string) string {
 
 		text := query
 		esc := regexp.QuoteMeta(abbr)
+		r.mu.Lock()
 		if data, ok := r.searchCache[esc]; ok {
 			match, next = data[0], data[1]
 		} else {
@@ -349,6 +670,7 @@ func (r *abbreviationReplacer) search(query string, list []string) string {
 			next = regexp.MustCompile(fmt.Sprintf(`%s (.{1})`, esc))
 			r.searchCache[esc] = []*regexp.Regexp{match, next}
 		}
+		r.mu.Unlock()
 
 		found := match.FindAllStringSubmatch(text, -1)
 		if len(found) > 0 {
@@ -385,41 +707,48 @@ func (r *abbreviationReplacer) scan(text, am string, idx int, chars []string) st
 
 func (r *abbreviationReplacer) replacePrepositive(text, abbr string) string {
 	abbr = strings.ToLower(strings.TrimSpace(abbr))
-	if rules, ok := r.prepositiveCache[abbr]; ok {
-		return applyRules(text, rules)
-	}
-	q1 := fmt.Sprintf(`(?i)\s%s(\.)\s|^%s(\.)\s`, abbr, abbr)
-	q2 := fmt.Sprintf(`(?i)\s%s(\.):\d+|^%s(\.):\d+`, abbr, abbr)
-	r1 := rule{pattern: regexp.MustCompile(q1), replacement: "∯"}
-	r2 := rule{pattern: regexp.MustCompile(q2), replacement: "∯"}
-	r.prepositiveCache[abbr] = []rule{r1, r2}
-	return r2.sub(r1.sub(text))
+	return applyRules(text, r.cachedRules(r.prepositiveCache, abbr, func() []string {
+		return []string{
+			fmt.Sprintf(`(?i)\s%s(\.)\s|^%s(\.)\s`, abbr, abbr),
+			fmt.Sprintf(`(?i)\s%s(\.):\d+|^%s(\.):\d+`, abbr, abbr)}
+	}))
 }
 
 func (r *abbreviationReplacer) replaceNumber(text, abbr string) string {
 	abbr = strings.ToLower(strings.TrimSpace(abbr))
-	if rules, ok := r.numberCache[abbr]; ok {
-		return applyRules(text, rules)
-	}
-	q1 := fmt.Sprintf(`(?i)\s%s(\.)\s\d|^%s(\.)\s\d`, abbr, abbr)
-	q2 := fmt.Sprintf(`(?i)\s%s(\.)\s+\(|^%s(\.)\s+\(`, abbr, abbr)
-	r1 := rule{pattern: regexp.MustCompile(q1), replacement: "∯"}
-	r2 := rule{pattern: regexp.MustCompile(q2), replacement: "∯"}
-	r.numberCache[abbr] = []rule{r1, r2}
-	return r2.sub(r1.sub(text))
+	return applyRules(text, r.cachedRules(r.numberCache, abbr, func() []string {
+		return []string{
+			fmt.Sprintf(`(?i)\s%s(\.)\s\d|^%s(\.)\s\d`, abbr, abbr),
+			fmt.Sprintf(`(?i)\s%s(\.)\s+\(|^%s(\.)\s+\(`, abbr, abbr)}
+	}))
 }
 
 func (r *abbreviationReplacer) replacePeriod(text, abbr string) string {
 	abbr = strings.TrimSpace(abbr)
-	if rules, ok := r.periodCache[abbr]; ok {
-		return applyRules(text, rules)
+	return applyRules(text, r.cachedRules(r.periodCache, abbr, func() []string {
+		return []string{
+			fmt.Sprintf(`\s%s(\.)(?:(?:(?:\.|\:|-|\?)|(?:\s(?:[a-z]|I\s|I'm|I'll|\d))))|^%s(\.)(?:(?:(?:\.|\:|\?)|(?:\s(?:[a-z]|I\s|I'm|I'll|\d))))`, abbr, abbr),
+			fmt.Sprintf(`\s%s(\.),|^%s(\.),`, abbr, abbr)}
+	}))
+}
+
+// cachedRules returns the rules stored under abbr in cache, compiling the
+// patterns returned by build (each of which replaces its groups with "∯") if
+// they aren't there yet.
+func (r *abbreviationReplacer) cachedRules(
+	cache map[string][]rule, abbr string, build func() []string) []rule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if rules, ok := cache[abbr]; ok {
+		return rules
 	}
-	q1 := fmt.Sprintf(`\s%s(\.)(?:(?:(?:\.|\:|-|\?)|(?:\s(?:[a-z]|I\s|I'm|I'll|\d))))|^%s(\.)(?:(?:(?:\.|\:|\?)|(?:\s(?:[a-z]|I\s|I'm|I'll|\d))))`, abbr, abbr)
-	q2 := fmt.Sprintf(`\s%s(\.),|^%s(\.),`, abbr, abbr)
-	r1 := rule{pattern: regexp.MustCompile(q1), replacement: "∯"}
-	r2 := rule{pattern: regexp.MustCompile(q2), replacement: "∯"}
-	r.periodCache[abbr] = []rule{r1, r2}
-	return r2.sub(r1.sub(text))
+	rules := []rule{}
+	for _, pattern := range build() {
+		rules = append(rules, rule{
+			pattern: regexp.MustCompile(pattern), replacement: "∯"})
+	}
+	cache[abbr] = rules
+	return rules
 }
 
 func (r *abbreviationReplacer) replaceBoundary(text string) string {
@@ -441,6 +770,9 @@ func (r *abbreviationReplacer) replaceMultiPeriods(text string) string {
 var langToDefinition = map[string]languageDefinition{
 	"fr": new(frenchDefinition),
 	"es": new(spanishDefinition),
+	"de": new(germanDefinition),
+	"zh": new(cjkDefinition),
+	"ja": new(cjkDefinition),
 }
 
 type languageDefinition interface {
@@ -452,6 +784,9 @@ type languageDefinition interface {
 	subRules() []rule
 	subEllipsis() []rule
 	starters() []string
+	numberRules() []rule
+	singleLetterRules() []rule
+	doubleQuotes() []*regexp.Regexp
 }
 
 type commonDefinition struct{}
@@ -564,6 +899,18 @@ func (d *commonDefinition) starters() []string {
 		"When", "Where", "Who", "Why"}
 }
 
+func (d *commonDefinition) numberRules() []rule {
+	return allNumberRules
+}
+
+func (d *commonDefinition) singleLetterRules() []rule {
+	return allSingleUpperCaseLetterRules
+}
+
+func (d *commonDefinition) doubleQuotes() []*regexp.Regexp {
+	return []*regexp.Regexp{betweenDoubleQuotesRE}
+}
+
 type frenchDefinition struct {
 	commonDefinition
 }
@@ -644,16 +991,110 @@ func (s *spanishDefinition) abbreviations() map[string][]string {
 
 func (s *spanishDefinition) starters() []string { return []string{} }
 
+type germanDefinition struct {
+	commonDefinition
+}
+
+var germanAbbreviations = []string{
+	"Ä", "ä", "adj", "adm", "adv", "art", "asst", "b.a", "b.s", "bart",
+	"bldg", "brig", "bros", "bse", "buchst", "bzgl", "bzw", "c.-à-d", "ca",
+	"capt", "chr", "cmdr", "co", "col", "comdr", "con", "corp", "cpl", "d.h",
+	"d.j", "dergl", "dgl", "dkr", "dr", "ens", "etc", "ev", "evtl", "ff",
+	"g.g.a", "g.u", "gen", "ggf", "gov", "hon", "hosp", "i.f", "i.h.v", "ii",
+	"iii", "insp", "iv", "ix", "jun", "k.o", "kath", "lfd", "lt", "ltd",
+	"m.e", "maj", "med", "messrs", "mio", "mlle", "mm", "mme", "mr", "mrd",
+	"mrs", "ms", "msgr", "mwst", "no", "nos", "nr", "o.ä", "op", "ord",
+	"pfc", "ph", "pp", "prof", "pvt", "rep", "reps", "res", "rev", "rt",
+	"s.p.a", "sa", "sen", "sens", "sfc", "sgt", "sog", "sogen", "spp", "sr",
+	"st", "std", "str", "supt", "surg", "u.a", "u.e", "u.s.w", "u.u", "u.ä",
+	"usf", "usw", "v", "vgl", "vi", "vii", "viii", "vs", "x", "xi", "xii",
+	"xiii", "xiv", "xix", "xv", "xvi", "xvii", "xviii", "xx", "z.b", "z.t",
+	"z.z", "z.zt", "zt", "zzt", "univ.-prof", "o.univ.-prof", "ao.univ.prof",
+	"ass.prof", "hon.prof", "univ.-doz", "univ.ass", "stud.ass", "projektass",
+	"ass", "di", "dipl.-ing", "mag"}
+
+// In German, a period following a known abbreviation is never treated as a
+// sentence boundary--regardless of the case of the next word (e.g., "Dr.
+// Schmidt" or "z. B. Äpfel")--so every abbreviation is prepositive.
+func (g *germanDefinition) abbreviations() map[string][]string {
+	return map[string][]string{
+		"abbreviations": germanAbbreviations,
+		"prepositive":   germanAbbreviations,
+		"number":        {"art", "ca", "no", "nos", "nr", "pp"},
+	}
+}
+
+func (g *germanDefinition) starters() []string {
+	return []string{
+		"Am", "Auch", "Auf", "Bei", "Da", "Das", "Der", "Die", "Ein", "Eine",
+		"Es", "Für", "Heute", "Ich", "Im", "In", "Ist", "Jetzt", "Mein", "Mit",
+		"Nach", "So", "Und", "Warum", "Was", "Wenn", "Wer", "Wie", "Wir"}
+}
+
+// Ordinal numbers (e.g., "am 3. Oktober") are written with a trailing period.
+var germanNumberRules = append([]rule{
+	{pattern: regexp.MustCompile(`\s\d\d?(\.)\s`), replacement: "∯"},
+	{pattern: regexp.MustCompile(`-\d\d?(\.)\s`), replacement: "∯"},
+	{pattern: regexp.MustCompile(
+		`\d(\.)\s*(?:Januar|Februar|März|April|Mai|Juni|Juli|August|` +
+			`September|Oktober|November|Dezember)`), replacement: "∯"},
+}, allNumberRules...)
+
+func (g *germanDefinition) numberRules() []rule {
+	return germanNumberRules
+}
+
+var germanSingleLetterRules = append([]rule{
+	{pattern: regexp.MustCompile(`^[a-z](\.)\s`), replacement: "∯"},
+	{pattern: regexp.MustCompile(`\s[a-z](\.)\s`), replacement: "∯"},
+}, allSingleUpperCaseLetterRules...)
+
+func (g *germanDefinition) singleLetterRules() []rule {
+	return germanSingleLetterRules
+}
+
+// German quotations are typically written as „…“ (or, informally, ,,…“).
+var betweenGermanQuotesRE = regexp.MustCompile(`„([^“\\]+|\\{2}|\\.)*“`)
+var betweenInformalGermanQuotesRE = regexp.MustCompile(`,,([^“\\]+|\\{2}|\\.)*“`)
+
+func (g *germanDefinition) doubleQuotes() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		betweenDoubleQuotesRE, betweenGermanQuotesRE,
+		betweenInformalGermanQuotesRE}
+}
+
+// Chinese and Japanese share their sentence-ending punctuation (。！？), which
+// is already covered by commonDefinition, but they quote with brackets rather
+// than with quotation marks.
+type cjkDefinition struct {
+	commonDefinition
+}
+
+var betweenCornerBracketsRE = regexp.MustCompile(`「([^「」\\]+|\\{2}|\\.)*」`)
+var betweenWhiteCornerBracketsRE = regexp.MustCompile(`『([^『』\\]+|\\{2}|\\.)*』`)
+var betweenDoubleAngleBracketsRE = regexp.MustCompile(`《([^《》\\]+|\\{2}|\\.)*》`)
+var betweenFullWidthParensRE = regexp.MustCompile(`（([^（）\\]+|\\{2}|\\.)*）`)
+
+func (c *cjkDefinition) doubleQuotes() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		betweenDoubleQuotesRE, betweenCornerBracketsRE,
+		betweenWhiteCornerBracketsRE, betweenDoubleAngleBracketsRE,
+		betweenFullWidthParensRE}
+}
+
 /* language processors */
 
 var langToProcessor = map[string]languageProcessor{
 	"en": newProcessor("en"),
 	"fr": newProcessor("fr"),
 	"es": newProcessor("es"),
+	"de": newProcessor("de"),
+	"zh": newProcessor("zh"),
+	"ja": newProcessor("ja"),
 }
 
 type languageProcessor interface {
-	process(text string) []string
+	process(text string, opts segmenterOptions) []string
 }
 
 type processor struct {
@@ -669,9 +1110,19 @@ func (p *processor) cleanQuotations(text string) string {
 	return substitute(text, "`", "'")
 }
 
-func (p *processor) process(text string) []string {
-	text = p.abbrReplacer.replace(applyRules(text, cleanRules))
-	text = applyRules(text, allNumberRules)
+func (p *processor) process(text string, opts segmenterOptions) []string {
+	if opts.docType == PDF {
+		text = removePDFLineBreaks(text)
+	} else {
+		text = applyRules(text, cleanRules)
+	}
+	if opts.lists {
+		r := listItemReplacer{text: text}
+		text = r.replace()
+	}
+
+	text = p.abbrReplacer.replace(text)
+	text = applyRules(text, p.abbrReplacer.definition.numberRules())
 
 	text = continuousPunctuationRE.ReplaceAllStringFunc(text, func(s string) string {
 		return substitute(substitute(s, "!", "&ᓴ&"), "?", "&ᓷ&")
@@ -721,7 +1172,7 @@ func (p *processor) processText(text string) []string {
 		text = text + "ȸ"
 	}
 	text = subPat(text, "double", exclamationWordsRE)
-	text = replaceBetweenQuotes(text)
+	text = replaceBetweenQuotes(text, p.abbrReplacer.definition.doubleQuotes())
 	text = applyRules(text, p.abbrReplacer.definition.doublePunctRules())
 	text = applyRules(text, p.abbrReplacer.definition.exclamationRules())
 	text = pRules["questionMarkInQuotation"].sub(text)
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
 )
 
 type goldenRule struct {
@@ -18,6 +20,61 @@ type goldenRule struct {
 func TestPragmaticRulesEn(t *testing.T) { testLang("en", t) }
 func TestPragmaticRulesFr(t *testing.T) { testLang("fr", t) }
 func TestPragmaticRulesEs(t *testing.T) { testLang("es", t) }
+func TestPragmaticRulesDe(t *testing.T) { testLang("de", t) }
+func TestPragmaticRulesZh(t *testing.T) { testLang("zh", t) }
+func TestPragmaticRulesJa(t *testing.T) { testLang("ja", t) }
+
+func TestPragmaticLists(t *testing.T) {
+	tok, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+	tok.Lists = true
+	testFile("golden_rules_lists.json", tok, t)
+}
+
+func TestPragmaticPDF(t *testing.T) {
+	tok, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+	tok.DocType = PDF
+	testFile("golden_rules_pdf.json", tok, t)
+
+	text := "Sentence segmen-\ntation is hard.\n\nHard-\nwrapped text is harder."
+	spans := tok.TokenizeSpans(text)
+	if assert.Equal(t, 2, len(spans)) {
+		assert.Equal(t, "Sentence segmen-\ntation is hard.", text[spans[0].Start:spans[0].End])
+		assert.Equal(t, "Hard-\nwrapped text is harder.", text[spans[1].Start:spans[1].End])
+	}
+}
+
+func TestPragmaticSplitParagraphs(t *testing.T) {
+	tok, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+
+	text := "One.\n \nTwo.\n\nThree."
+	assert.Equal(t, []string{"One.\n \nTwo.", "Three."}, tok.SplitParagraphs(text))
+	tok.DocType = PDF
+	assert.Equal(t, []string{"One.", "Two.", "Three."}, tok.SplitParagraphs(text))
+}
+
+func TestPragmaticConcurrent(t *testing.T) {
+	tests := make([]goldenRule, 0)
+	cases := util.ReadDataFile(filepath.Join(testdata, "golden_rules_en.json"))
+	util.CheckError(json.Unmarshal(cases, &tests))
+
+	// Segmenters for the same language share their abbreviation caches.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		tok, err := NewPragmaticSegmenter("en")
+		util.CheckError(err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, test := range tests {
+				compare(t, test.Name, test.Input, test.Output, tok)
+			}
+		}()
+	}
+	wg.Wait()
+}
 
 func BenchmarkPragmaticRulesEn(b *testing.B) { benchmarkLang("en", b) }
 
@@ -38,12 +95,14 @@ func benchmarkLang(lang string, b *testing.B) {
 }
 
 func testLang(lang string, t *testing.T) {
-	tests := make([]goldenRule, 0)
-	f := fmt.Sprintf("golden_rules_%s.json", lang)
-	cases := util.ReadDataFile(filepath.Join(testdata, f))
-
 	tok, err := NewPragmaticSegmenter(lang)
 	util.CheckError(err)
+	testFile(fmt.Sprintf("golden_rules_%s.json", lang), tok, t)
+}
+
+func testFile(f string, tok *PragmaticSegmenter, t *testing.T) {
+	tests := make([]goldenRule, 0)
+	cases := util.ReadDataFile(filepath.Join(testdata, f))
 
 	util.CheckError(json.Unmarshal(cases, &tests))
 	for _, test := range tests {
@@ -38,14 +38,27 @@ type PunktSentenceTokenizer struct {
 
 // NewPunktSentenceTokenizer creates a new PunktSentenceTokenizer and loads
 // its English model.
-func NewPunktSentenceTokenizer() *PunktSentenceTokenizer {
+//
+// If the model can't be loaded, the returned error is of the kind
+// ErrModelCorrupt.
+func NewPunktSentenceTokenizer() (*PunktSentenceTokenizer, error) {
 	var pt PunktSentenceTokenizer
 	var err error
 
 	pt.tokenizer, err = newSentenceTokenizer(nil)
-	util.CheckError(err)
+	if err != nil {
+		return nil, err
+	}
 
-	return &pt
+	return &pt, nil
+}
+
+// MustNewPunktSentenceTokenizer is like NewPunktSentenceTokenizer, but it
+// panics if the model can't be loaded.
+func MustNewPunktSentenceTokenizer() *PunktSentenceTokenizer {
+	pt, err := NewPunktSentenceTokenizer()
+	util.CheckError(err)
+	return pt
 }
 
 // Tokenize splits text into sentences.
@@ -57,6 +70,16 @@ func (p PunktSentenceTokenizer) Tokenize(text string) []string {
 	return sents
 }
 
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// sentence in text.
+func (p PunktSentenceTokenizer) TokenizeSpans(text string) []Span {
+	sents := []Span{}
+	for _, s := range p.tokenizer.Tokenize(text) {
+		sents = append(sents, Span{Text: s.Text, Start: s.Start, End: s.End})
+	}
+	return withRuneOffsets(text, sents)
+}
+
 type wordTokenizer struct {
 	sentences.DefaultWordTokenizer
 }
@@ -72,19 +95,20 @@ func newSentenceTokenizer(s *sentences.Storage) (*sentences.DefaultSentenceToken
 	if training == nil {
 		b, err := data.Asset("data/english.json")
 		if err != nil {
-			return nil, err
+			return nil, util.NewError(ErrModelCorrupt, err)
 		}
 
 		training, err = sentences.LoadTraining(b)
 		if err != nil {
-			return nil, err
+			return nil, util.NewError(ErrModelCorrupt, err)
 		}
-	}
 
-	// supervisor abbreviations
-	abbrevs := []string{"sgt", "gov", "no", "mt"}
-	for _, abbr := range abbrevs {
-		training.AbbrevTypes.Add(abbr)
+		// supervisor abbreviations (only for the built-in model; a trained
+		// model is used exactly as given)
+		abbrevs := []string{"sgt", "gov", "no", "mt"}
+		for _, abbr := range abbrevs {
+			training.AbbrevTypes.Add(abbr)
+		}
 	}
 
 	lang := sentences.NewPunctStrings()
@@ -8,7 +8,7 @@ import (
 	"github.com/jdkato/prose/internal/util"
 )
 
-var tokenizer = NewPunktSentenceTokenizer()
+var tokenizer = MustNewPunktSentenceTokenizer()
 
 func BenchmarkPunkt(b *testing.B) {
 	tests := make([]goldenRule, 0)
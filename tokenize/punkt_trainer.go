@@ -0,0 +1,436 @@
+package tokenize
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/jdkato/prose/internal/util"
+	"gopkg.in/neurosnap/sentences.v1"
+)
+
+// Thresholds used when learning parameters (see NLTK's PunktTrainer).
+const (
+	abbrevCutoff   = 0.3  // log-likelihood needed to be an abbreviation
+	abbrevBackoff  = 5    // upper bound on the frequency of rare abbreviations
+	collocCutoff   = 7.88 // log-likelihood needed to be a collocation
+	starterCutoff  = 30   // log-likelihood needed to be a sentence starter
+	minCollocCount = 1    // collocations must occur more often than this
+)
+
+// Orthographic contexts: the position in a sentence (beginning, middle, or
+// unknown) and the case of the first letter.
+const (
+	orthoBegUpper = 1 << 1
+	orthoMidUpper = 1 << 2
+	orthoUnkUpper = 1 << 3
+	orthoBegLower = 1 << 4
+	orthoMidLower = 1 << 5
+	orthoUnkLower = 1 << 6
+)
+
+const internalPunct = ",:;"
+const numberType = "##number##"
+
+var reNonPunct = regexp.MustCompile(`[^\W\d]`)
+
+// PunktTrainer learns the parameters used by a PunktSentenceTokenizer
+// (abbreviations, collocations, and frequent sentence starters) from raw,
+// unannotated text.
+//
+// This is a port of NLTK's PunktTrainer (see
+// http://www.nltk.org/_modules/nltk/tokenize/punkt.html). The learned
+// parameters are stored in the same JSON format as the models bundled with
+// https://github.com/neurosnap/sentences.
+type PunktTrainer struct {
+	storage    *sentences.Storage
+	word       *wordTokenizer
+	supervised []string
+
+	typeCounts    map[string]int
+	numTokens     int
+	periodTokens  int
+	sentBreaks    int
+	starterCounts map[string]int
+	collocCounts  map[[2]string]int
+}
+
+// NewPunktTrainer creates a new PunktTrainer with no prior knowledge.
+func NewPunktTrainer() *PunktTrainer {
+	return &PunktTrainer{
+		storage:       sentences.NewStorage(),
+		word:          newWordTokenizer(sentences.NewPunctStrings()),
+		typeCounts:    make(map[string]int),
+		starterCounts: make(map[string]int),
+		collocCounts:  make(map[[2]string]int)}
+}
+
+// AddAbbreviations adds known abbreviations (e.g., "approx" or "u.s.") to the
+// model. Unlike learned abbreviations, these are never discarded during
+// training.
+func (t *PunktTrainer) AddAbbreviations(abbrs ...string) {
+	for _, abbr := range abbrs {
+		abbr = strings.TrimSuffix(strings.ToLower(abbr), ".")
+		t.supervised = append(t.supervised, abbr)
+		t.storage.AbbrevTypes.Add(abbr)
+	}
+}
+
+// Train updates the model's statistics using the string text.
+//
+// Train may be called any number of times (e.g., once per document in a
+// corpus) before saving the model.
+func (t *PunktTrainer) Train(text string) {
+	tokens := t.tokenize(text)
+	types := make(map[string]bool)
+	for _, tok := range tokens {
+		typ := t.word.Type(tok)
+		t.typeCounts[typ]++
+		t.numTokens++
+		if t.word.HasPeriodFinal(tok) {
+			t.periodTokens++
+		}
+		types[typ] = true
+	}
+
+	t.reclassifyAbbrevTypes(types)
+	t.annotateFirstPass(tokens)
+	t.addOrthography(tokens)
+
+	for i, tok := range tokens {
+		if tok.SentBreak {
+			t.sentBreaks++
+		}
+		if i+1 == len(tokens) || !t.word.HasPeriodFinal(tok) {
+			continue
+		}
+		next := tokens[i+1]
+		if t.isRareAbbrevType(tok, next) {
+			t.storage.AbbrevTypes.Add(t.word.TypeNoPeriod(tok))
+		}
+		if t.isPotentialSentStarter(next, tok) {
+			t.starterCounts[t.word.Type(next)]++
+		}
+		if t.isPotentialCollocation(tok, next) {
+			pair := [2]string{
+				t.word.TypeNoPeriod(tok), t.word.TypeNoSentPeriod(next)}
+			t.collocCounts[pair]++
+		}
+	}
+}
+
+// Save writes the model's parameters, as JSON, to w.
+func (t *PunktTrainer) Save(w io.Writer) error {
+	t.finalize()
+	return json.NewEncoder(w).Encode(t.storage)
+}
+
+// NewTrainedPunktSentenceTokenizer creates a new PunktSentenceTokenizer from
+// a model (such as one written by PunktTrainer.Save) read from r.
+//
+// If the model can't be decoded, the returned error is of the kind
+// ErrModelCorrupt.
+func NewTrainedPunktSentenceTokenizer(r io.Reader) (*PunktSentenceTokenizer, error) {
+	var pt PunktSentenceTokenizer
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	training, err := sentences.LoadTraining(b)
+	if err != nil {
+		return nil, util.NewError(ErrModelCorrupt, err)
+	}
+
+	for _, set := range []*sentences.SetString{
+		&training.AbbrevTypes, &training.Collocations,
+		&training.SentStarters, &training.OrthoContext} {
+		if *set == nil {
+			*set = sentences.SetString{}
+		}
+	}
+
+	pt.tokenizer, err = newSentenceTokenizer(training)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pt, nil
+}
+
+// tokenize splits text on whitespace, recording which tokens start a line or
+// paragraph.
+func (t *PunktTrainer) tokenize(text string) []*sentences.Token {
+	tokens := []*sentences.Token{}
+	lineStart, paraStart := false, false
+	for _, line := range strings.Split(text, "\n") {
+		words := strings.FieldsFunc(line, unicode.IsSpace)
+		if len(words) == 0 {
+			paraStart = true
+			continue
+		}
+		for i, word := range words {
+			tok := sentences.NewToken(word)
+			if i == 0 {
+				tok.LineStart = lineStart
+				tok.ParaStart = paraStart
+			}
+			tokens = append(tokens, tok)
+		}
+		lineStart, paraStart = true, false
+	}
+	return tokens
+}
+
+// reclassifyAbbrevTypes decides, for each of the given types, whether or not
+// it's an abbreviation.
+func (t *PunktTrainer) reclassifyAbbrevTypes(types map[string]bool) {
+	for typ := range types {
+		var isAdd bool
+
+		if !reNonPunct.MatchString(typ) || typ == numberType {
+			continue
+		}
+
+		if strings.HasSuffix(typ, ".") {
+			if t.storage.AbbrevTypes.Has(typ) {
+				continue
+			}
+			typ = typ[:len(typ)-1]
+			isAdd = true
+		} else if !t.storage.AbbrevTypes.Has(typ) {
+			continue
+		}
+
+		numPeriods := float64(strings.Count(typ, ".") + 1)
+		numNonPeriods := float64(len(typ)) - numPeriods + 1
+
+		withPeriod := float64(t.typeCounts[typ+"."])
+		withoutPeriod := float64(t.typeCounts[typ])
+
+		ll := dunningLogLikelihood(
+			withPeriod+withoutPeriod, float64(t.periodTokens), withPeriod,
+			float64(t.numTokens))
+
+		fLength := math.Exp(-numNonPeriods)
+		fPenalty := math.Pow(numNonPeriods, -withoutPeriod)
+
+		score := ll * fLength * numPeriods * fPenalty
+		if score >= abbrevCutoff {
+			if isAdd {
+				t.storage.AbbrevTypes.Add(typ)
+			}
+		} else if !isAdd && !util.StringInSlice(typ, t.supervised) {
+			t.storage.AbbrevTypes.Remove(typ)
+		}
+	}
+}
+
+// annotateFirstPass marks sentence breaks and abbreviations based solely on
+// each token's type.
+func (t *PunktTrainer) annotateFirstPass(tokens []*sentences.Token) {
+	for _, tok := range tokens {
+		if t.word.HasSentEndChars(tok) || tok.Tok == "." {
+			tok.SentBreak = true
+		} else if t.word.HasPeriodFinal(tok) && !strings.HasSuffix(tok.Tok, "..") {
+			noPeriod := strings.ToLower(tok.Tok[:len(tok.Tok)-1])
+			parts := strings.Split(noPeriod, "-")
+			if t.storage.IsAbbr(noPeriod, parts[len(parts)-1]) {
+				tok.Abbr = true
+			} else {
+				tok.SentBreak = true
+			}
+		}
+	}
+}
+
+// addOrthography records the orthographic contexts (i.e., the position in
+// a sentence and the case of the first letter) in which each type occurs.
+func (t *PunktTrainer) addOrthography(tokens []*sentences.Token) {
+	context := "internal"
+	for _, tok := range tokens {
+		if tok.ParaStart && context != "unknown" {
+			context = "initial"
+		}
+		if tok.LineStart && context == "internal" {
+			context = "unknown"
+		}
+
+		flag := 0
+		upper, lower := t.word.FirstUpper(tok), t.word.FirstLower(tok)
+		switch {
+		case context == "initial" && upper:
+			flag = orthoBegUpper
+		case context == "internal" && upper:
+			flag = orthoMidUpper
+		case context == "unknown" && upper:
+			flag = orthoUnkUpper
+		case context == "initial" && lower:
+			flag = orthoBegLower
+		case context == "internal" && lower:
+			flag = orthoMidLower
+		case context == "unknown" && lower:
+			flag = orthoUnkLower
+		}
+		if flag != 0 {
+			typ := t.word.TypeNoSentPeriod(tok)
+			t.storage.OrthoContext[typ] |= flag
+		}
+
+		if tok.SentBreak {
+			if !(t.isNumber(tok) || t.word.IsInitial(tok)) {
+				context = "initial"
+			} else {
+				context = "unknown"
+			}
+		} else if tok.Abbr || t.word.IsEllipsis(tok) {
+			context = "unknown"
+		} else {
+			context = "internal"
+		}
+	}
+}
+
+// isRareAbbrevType determines if cur is an infrequent abbreviation that was
+// incorrectly marked as a sentence break.
+func (t *PunktTrainer) isRareAbbrevType(cur, next *sentences.Token) bool {
+	if cur.Abbr || !cur.SentBreak {
+		return false
+	}
+
+	typ := t.word.TypeNoSentPeriod(cur)
+	count := t.typeCounts[typ] + t.typeCounts[typ[:len(typ)-1]]
+	if t.storage.AbbrevTypes.Has(typ) || count >= abbrevBackoff {
+		return false
+	}
+
+	if next.Tok != "" && strings.ContainsRune(internalPunct, rune(next.Tok[0])) {
+		return true
+	} else if t.word.FirstLower(next) {
+		ortho := t.storage.OrthoContext[t.word.TypeNoSentPeriod(next)]
+		if ortho&orthoBegUpper != 0 && ortho&orthoMidUpper == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPotentialSentStarter determines if cur could be a sentence starter.
+func (t *PunktTrainer) isPotentialSentStarter(cur, prev *sentences.Token) bool {
+	return prev.SentBreak &&
+		!(t.isNumber(prev) || t.word.IsInitial(prev)) &&
+		t.word.IsAlpha(cur)
+}
+
+// isPotentialCollocation determines if tok1 and tok2 could form a
+// collocation (e.g., "[Number]. [Month]").
+func (t *PunktTrainer) isPotentialCollocation(tok1, tok2 *sentences.Token) bool {
+	return tok1.SentBreak &&
+		(t.isNumber(tok1) || t.word.IsInitial(tok1)) &&
+		t.isNonPunct(tok1) && t.isNonPunct(tok2)
+}
+
+// finalize converts the collected statistics into collocations and sentence
+// starters.
+func (t *PunktTrainer) finalize() {
+	n := float64(t.numTokens)
+
+	t.storage.SentStarters = sentences.SetString{}
+	for typ, atBreak := range t.starterCounts {
+		if typ == "" {
+			continue
+		}
+		count := t.typeCounts[typ] + t.typeCounts[typ+"."]
+		if count < atBreak {
+			continue
+		}
+		ll := collocLogLikelihood(
+			float64(t.sentBreaks), float64(count), float64(atBreak), n)
+		if ll >= starterCutoff &&
+			n/float64(t.sentBreaks) > float64(count)/float64(atBreak) {
+			t.storage.SentStarters.Add(typ)
+		}
+	}
+
+	t.storage.Collocations = sentences.SetString{}
+	for pair, count := range t.collocCounts {
+		if t.storage.SentStarters.Has(pair[1]) {
+			continue
+		}
+		count1 := t.typeCounts[pair[0]] + t.typeCounts[pair[0]+"."]
+		count2 := t.typeCounts[pair[1]] + t.typeCounts[pair[1]+"."]
+		if count1 > 1 && count2 > 1 && minCollocCount < count &&
+			count <= util.Min(count1, count2) {
+			ll := collocLogLikelihood(
+				float64(count1), float64(count2), float64(count), n)
+			if ll >= collocCutoff &&
+				n/float64(count1) > float64(count2)/float64(count) {
+				t.storage.Collocations.Add(pair[0] + "," + pair[1])
+			}
+		}
+	}
+}
+
+func (t *PunktTrainer) isNumber(tok *sentences.Token) bool {
+	return strings.HasPrefix(t.word.Type(tok), numberType)
+}
+
+func (t *PunktTrainer) isNonPunct(tok *sentences.Token) bool {
+	return reNonPunct.MatchString(t.word.Type(tok))
+}
+
+// dunningLogLikelihood calculates the modified Dunning log-likelihood ratio
+// used to score abbreviations.
+func dunningLogLikelihood(countA, countB, countAB, n float64) float64 {
+	p1 := countB / n
+	p2 := 0.99
+
+	null := xlogy(countAB, p1) + xlogy(countA-countAB, 1.0-p1)
+	alt := xlogy(countAB, p2) + xlogy(countA-countAB, 1.0-p2)
+
+	return -2.0 * (null - alt)
+}
+
+// collocLogLikelihood calculates the Dunning log-likelihood ratio used to
+// score collocations and sentence starters.
+func collocLogLikelihood(countA, countB, countAB, n float64) float64 {
+	var summand1, summand2, summand3, summand4 float64
+
+	p := countB / n
+	p1 := countAB / countA
+	p2 := 1.0
+	if n != countA {
+		p2 = (countB - countAB) / (n - countA)
+	}
+
+	if p > 0 && p < 1 {
+		summand1 = countAB*math.Log(p) + (countA-countAB)*math.Log(1.0-p)
+		summand2 = (countB-countAB)*math.Log(p) +
+			(n-countA-countB+countAB)*math.Log(1.0-p)
+	}
+
+	if countA != countAB && p1 > 0 && p1 < 1 {
+		summand3 = countAB*math.Log(p1) + (countA-countAB)*math.Log(1.0-p1)
+	}
+
+	if countB != countAB && p2 > 0 && p2 < 1 {
+		summand4 = (countB-countAB)*math.Log(p2) +
+			(n-countA-countB+countAB)*math.Log(1.0-p2)
+	}
+
+	return -2.0 * (summand1 + summand2 - summand3 - summand4)
+}
+
+// xlogy returns x * log(y), treating 0 * log(0) as 0.
+func xlogy(x, y float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return x * math.Log(y)
+}
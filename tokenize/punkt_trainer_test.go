@@ -0,0 +1,60 @@
+package tokenize
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/neurosnap/sentences.v1"
+)
+
+func TestPunktTrainer(t *testing.T) {
+	trainer := NewPunktTrainer()
+	trainer.AddAbbreviations("approx.")
+	trainer.Train(string(util.ReadDataFile(filepath.Join(testdata, "sherlock.txt"))))
+
+	dir, err := ioutil.TempDir("", "punkt")
+	util.CheckError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sherlock.json")
+	f, err := os.Create(path)
+	util.CheckError(err)
+	util.CheckError(trainer.Save(f))
+	util.CheckError(f.Close())
+
+	f, err = os.Open(path)
+	util.CheckError(err)
+	defer f.Close()
+
+	tok, err := NewTrainedPunktSentenceTokenizer(f)
+	util.CheckError(err)
+	assert.Equal(t, trainer.storage.AbbrevTypes, tok.tokenizer.AbbrevTypes)
+
+	assert.Equal(t, []string{
+		"Dr. Watson met Mr. Holmes in St. James's Street.",
+		" It weighed approx. 5 pounds in total.",
+	}, tok.Tokenize(
+		"Dr. Watson met Mr. Holmes in St. James's Street. It weighed approx. 5 pounds in total."))
+}
+
+func TestNewTrainedPunktSentenceTokenizer(t *testing.T) {
+	tok, err := NewTrainedPunktSentenceTokenizer(bytes.NewBufferString(
+		`{"AbbrevTypes": {"fig": 1}}`))
+	util.CheckError(err)
+
+	assert.Equal(t, []string{"See fig. 3 for details.", " It is clear."},
+		tok.Tokenize("See fig. 3 for details. It is clear."))
+
+	// The built-in model's extra abbreviations aren't added to a trained one.
+	assert.Equal(t, sentences.SetString{"fig": 1}, tok.tokenizer.AbbrevTypes)
+	assert.Equal(t, []string{"I said no.", " Then I left."},
+		tok.Tokenize("I said no. Then I left."))
+
+	_, err = NewTrainedPunktSentenceTokenizer(bytes.NewBufferString("{"))
+	assert.True(t, IsKind(err, ErrModelCorrupt))
+}
@@ -1,6 +1,10 @@
 package tokenize
 
-import "regexp"
+import (
+	"regexp"
+
+	"github.com/jdkato/prose/internal/util"
+)
 
 // RegexpTokenizer splits a string into substrings using a regular expression.
 type RegexpTokenizer struct {
@@ -13,10 +17,24 @@ type RegexpTokenizer struct {
 // arguments: a pattern to base the tokenizer on, a boolean value indicating
 // whether or not to look for separators between tokens, and boolean value
 // indicating whether or not to discard empty tokens.
-func NewRegexpTokenizer(pattern string, gaps, discard bool) *RegexpTokenizer {
-	rTok := RegexpTokenizer{
-		regex: regexp.MustCompile(pattern), gaps: gaps, discard: discard}
-	return &rTok
+//
+// If pattern can't be compiled, the returned error is of the kind
+// ErrBadPattern.
+func NewRegexpTokenizer(pattern string, gaps, discard bool) (*RegexpTokenizer, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, util.NewError(ErrBadPattern, err)
+	}
+	rTok := RegexpTokenizer{regex: regex, gaps: gaps, discard: discard}
+	return &rTok, nil
+}
+
+// MustNewRegexpTokenizer is like NewRegexpTokenizer, but it panics if pattern
+// can't be compiled.
+func MustNewRegexpTokenizer(pattern string, gaps, discard bool) *RegexpTokenizer {
+	rTok, err := NewRegexpTokenizer(pattern, gaps, discard)
+	util.CheckError(err)
+	return rTok
 }
 
 // Tokenize splits text into a slice of tokens according to its regexp pattern.
@@ -39,6 +57,35 @@ func (r RegexpTokenizer) Tokenize(text string) []string {
 	return tokens
 }
 
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// token in text.
+func (r RegexpTokenizer) TokenizeSpans(text string) []Span {
+	spans := []Span{}
+	if r.gaps {
+		// This mirrors the behavior of (*regexp.Regexp).Split.
+		if len(text) == 0 && !r.discard {
+			return []Span{{}}
+		}
+		beg, end := 0, 0
+		for _, loc := range r.regex.FindAllStringIndex(text, -1) {
+			end = loc[0]
+			if loc[1] != 0 && (!r.discard || beg != end) {
+				spans = append(spans, Span{Text: text[beg:end], Start: beg, End: end})
+			}
+			beg = loc[1]
+		}
+		if end != len(text) && (!r.discard || beg != len(text)) {
+			spans = append(spans, Span{Text: text[beg:], Start: beg, End: len(text)})
+		}
+	} else {
+		for _, loc := range r.regex.FindAllStringIndex(text, -1) {
+			spans = append(spans, Span{
+				Text: text[loc[0]:loc[1]], Start: loc[0], End: loc[1]})
+		}
+	}
+	return withRuneOffsets(text, spans)
+}
+
 // NewBlanklineTokenizer is a RegexpTokenizer constructor.
 //
 // This tokenizer splits on any sequence of blank lines.
@@ -1,8 +1,10 @@
 package tokenize
 
 import (
+	"regexp/syntax"
 	"testing"
 
+	"github.com/jdkato/prose/internal/util"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,12 +19,26 @@ func TestWordPunctTokenizer(t *testing.T) {
 func TestNewRegexpTokenizer(t *testing.T) {
 	input, _ := getWordData("word_punct.json")
 	expected := NewWordPunctTokenizer()
-	observed := NewRegexpTokenizer(`\w+|[^\w\s]+`, false, false)
+	observed := MustNewRegexpTokenizer(`\w+|[^\w\s]+`, false, false)
 	for _, s := range input {
 		assert.Equal(t, expected.Tokenize(s), observed.Tokenize(s))
 	}
 }
 
+func TestNewRegexpTokenizerError(t *testing.T) {
+	tok, err := NewRegexpTokenizer(`[a-z`, false, false)
+	assert.Nil(t, tok)
+	assert.True(t, IsKind(err, ErrBadPattern))
+	assert.EqualError(t, err,
+		"bad pattern: error parsing regexp: missing closing ]: `[a-z`")
+
+	// The underlying *syntax.Error is still available (e.g., to errors.As).
+	_, ok := err.(*util.Error).Unwrap().(*syntax.Error)
+	assert.True(t, ok)
+
+	assert.Panics(t, func() { MustNewRegexpTokenizer(`[a-z`, false, false) })
+}
+
 func BenchmarkWordPunctTokenizer(b *testing.B) {
 	word := NewWordPunctTokenizer()
 	for n := 0; n < b.N; n++ {
@@ -0,0 +1,162 @@
+package tokenize
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// A SentenceScanner reads sentences, one at a time, from an io.Reader.
+//
+// Rather than reading all of its input into memory, a SentenceScanner reads
+// ChunkSize bytes at a time and segments them using its SpanTokenizer (e.g.,
+// a PunktSentenceTokenizer or PragmaticSegmenter). The last Lookahead
+// sentences of each chunk are held back--since they may be incomplete or
+// influenced by the text that follows them--and segmented again along with
+// the next chunk. (A PragmaticSegmenter, whose rules can span multiple
+// sentences on the same line, is only split at the start of a line.)
+//
+// If no sentences can be released--e.g., a PragmaticSegmenter is given text
+// without line breaks--the held-back text keeps growing until it reaches
+// MaxBuffer bytes, at which point all but its last sentence are released
+// anyway (or, if it's all one sentence, that sentence is split). So, memory
+// usage is bounded by a small multiple of MaxBuffer.
+//
+// Usage mirrors that of bufio.Scanner:
+//
+//    s := NewSentenceScanner(r, MustNewPunktSentenceTokenizer())
+//    for s.Scan() {
+//        fmt.Println(s.Text())
+//    }
+//    if err := s.Err(); err != nil {
+//        ...
+//    }
+type SentenceScanner struct {
+	ChunkSize int // number of bytes read at a time (defaults to 64KB)
+	Lookahead int // number of sentences held back from each chunk (at least 1)
+	MaxBuffer int // number of bytes held back before forcing a split (defaults to 16 chunks)
+
+	r         io.Reader
+	tokenizer SpanTokenizer
+	buf       []byte
+	offset    int // byte offset of buf in the stream
+	runes     int // rune offset of buf in the stream
+	queue     []Span
+	current   Span
+	eof       bool
+	err       error
+}
+
+// A boundaryChecker is a SpanTokenizer that doesn't necessarily segment
+// text[:i] and text[i:] the same way as text, even if a sentence starts at i.
+type boundaryChecker interface {
+	isBoundary(text string, i int) bool
+}
+
+// NewSentenceScanner creates a new SentenceScanner that reads from r and
+// segments its content with t.
+func NewSentenceScanner(r io.Reader, t SpanTokenizer) *SentenceScanner {
+	return &SentenceScanner{
+		ChunkSize: 64 * 1024, Lookahead: 2, r: r, tokenizer: t}
+}
+
+// Scan advances the SentenceScanner to the next sentence, which will then be
+// available through the Text and Span methods. It returns false when there
+// are no more sentences, either by reaching the end of the input or an error.
+func (s *SentenceScanner) Scan() bool {
+	for len(s.queue) == 0 {
+		if s.eof || s.err != nil {
+			return false
+		}
+		s.fill()
+	}
+	s.current, s.queue = s.queue[0], s.queue[1:]
+	return true
+}
+
+// Text returns the most recent sentence found by a call to Scan.
+func (s *SentenceScanner) Text() string {
+	return s.current.Text
+}
+
+// Span returns the most recent sentence found by a call to Scan along with
+// its location in the stream.
+func (s *SentenceScanner) Span() Span {
+	return s.current
+}
+
+// Err returns the first non-EOF error encountered by the SentenceScanner.
+func (s *SentenceScanner) Err() error {
+	return s.err
+}
+
+// fill reads the next chunk of input and queues the sentences that are no
+// longer subject to change.
+func (s *SentenceScanner) fill() {
+	size := s.ChunkSize
+	if size <= 0 {
+		size = 64 * 1024
+	}
+
+	chunk := make([]byte, size)
+	n, err := io.ReadFull(s.r, chunk)
+	s.buf = append(s.buf, chunk[:n]...)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		s.eof = true
+	} else if err != nil {
+		s.err = err
+		return
+	}
+
+	// Never split a multi-byte character across two chunks.
+	end := len(s.buf)
+	if !s.eof {
+		for i := 1; i < utf8.UTFMax && i <= end; i++ {
+			if utf8.RuneStart(s.buf[end-i]) {
+				if !utf8.FullRune(s.buf[end-i:]) {
+					end -= i
+				}
+				break
+			}
+		}
+	}
+
+	text := string(s.buf[:end])
+	spans := s.tokenizer.TokenizeSpans(text)
+	if !s.eof {
+		held := s.Lookahead
+		if held < 1 {
+			held = 1
+		}
+		cut := len(spans) - held
+		if b, ok := s.tokenizer.(boundaryChecker); ok {
+			for cut > 0 && !b.isBoundary(text, spans[cut].Start) {
+				cut--
+			}
+		}
+		limit := s.MaxBuffer
+		if limit <= 0 {
+			limit = 16 * size
+		}
+		if cut <= 0 && len(s.buf) >= limit {
+			cut = len(spans) - 1
+		}
+		if cut > 0 {
+			end = spans[cut].Start
+			spans = spans[:cut]
+		} else if len(s.buf) < limit {
+			return
+		}
+	}
+
+	for _, span := range spans {
+		span.Start += s.offset
+		span.End += s.offset
+		span.RuneStart += s.runes
+		span.RuneEnd += s.runes
+		s.queue = append(s.queue, span)
+	}
+
+	s.offset += end
+	s.runes += utf8.RuneCount(s.buf[:end])
+	s.buf = append([]byte(nil), s.buf[end:]...)
+}
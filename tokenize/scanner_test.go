@@ -0,0 +1,130 @@
+package tokenize
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSentenceScanner() {
+	r := strings.NewReader("Hello World. My name is Jonas. What is your name?")
+	s := NewSentenceScanner(r, MustNewPunktSentenceTokenizer())
+	for s.Scan() {
+		fmt.Printf("%q\n", s.Text())
+	}
+	// Output:
+	// "Hello World."
+	// " My name is Jonas."
+	// " What is your name?"
+}
+
+func scanAll(s *SentenceScanner) ([]string, []Span) {
+	sents, spans := []string{}, []Span{}
+	for s.Scan() {
+		sents = append(sents, s.Text())
+		spans = append(spans, s.Span())
+	}
+	util.CheckError(s.Err())
+	return sents, spans
+}
+
+func TestSentenceScanner(t *testing.T) {
+	text := string(util.ReadDataFile(filepath.Join(testdata, "sherlock.txt")))[:20000]
+
+	pragmatic, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+
+	for _, tok := range []SpanTokenizer{MustNewPunktSentenceTokenizer(), pragmatic} {
+		expected := tok.TokenizeSpans(text)
+		for _, size := range []int{1000, 4096} {
+			s := NewSentenceScanner(strings.NewReader(text), tok)
+			s.ChunkSize = size
+			sents, spans := scanAll(s)
+			assert.Equal(t, tok.Tokenize(text), sents)
+			assert.Equal(t, expected, spans)
+		}
+	}
+}
+
+func TestSentenceScannerUnicode(t *testing.T) {
+	text := strings.Repeat("Ünïcödé “quotes” are fine. Émigrés say so! ", 50)
+	tok := MustNewPunktSentenceTokenizer()
+
+	s := NewSentenceScanner(iotest.OneByteReader(strings.NewReader(text)), tok)
+	s.ChunkSize = 7
+	sents, spans := scanAll(s)
+	assert.Equal(t, tok.Tokenize(text), sents)
+	assert.Equal(t, tok.TokenizeSpans(text), spans)
+}
+
+func TestSentenceScannerError(t *testing.T) {
+	r := iotest.TimeoutReader(bytes.NewBufferString("One. Two. Three."))
+	s := NewSentenceScanner(r, MustNewPunktSentenceTokenizer())
+	s.ChunkSize = 4
+	for s.Scan() {
+	}
+	assert.Equal(t, iotest.ErrTimeout, s.Err())
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+// scanBuffered is like scanAll, but it also returns the largest number of
+// bytes read past the end of a sentence by the time it was scanned.
+func scanBuffered(s *SentenceScanner, r *countingReader) ([]string, int) {
+	sents, largest := []string{}, 0
+	for s.Scan() {
+		sents = append(sents, s.Text())
+		if ahead := r.n - s.Span().End; ahead > largest {
+			largest = ahead
+		}
+	}
+	util.CheckError(s.Err())
+	return sents, largest
+}
+
+func TestSentenceScannerBounded(t *testing.T) {
+	text := strings.Repeat("This is a sentence without a line break. ", 1000)
+	pragmatic, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+
+	r := &countingReader{r: strings.NewReader(text)}
+	s := NewSentenceScanner(r, pragmatic)
+	s.ChunkSize = 256
+	sents, largest := scanBuffered(s, r)
+	assert.Len(t, sents, 1000)
+	for _, sent := range sents {
+		assert.Equal(t, "This is a sentence without a line break.", sent)
+	}
+	assert.True(t, largest <= 2*16*256)
+}
+
+func TestSentenceScannerIndented(t *testing.T) {
+	text := strings.Repeat("  This line is indented. So is this one.\n", 200)
+	pragmatic, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+
+	r := &countingReader{r: strings.NewReader(text)}
+	s := NewSentenceScanner(r, pragmatic)
+	s.ChunkSize = 256
+	s.MaxBuffer = len(text) // only split where isBoundary allows it
+	sents, largest := scanBuffered(s, r)
+	assert.Equal(t, pragmatic.Tokenize(text), sents)
+	assert.True(t, largest < 1024)
+}
@@ -3,25 +3,252 @@ Package tokenize implements functions to split strings into slices of substrings
 */
 package tokenize
 
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jdkato/prose/internal/util"
+)
+
+// The kinds of errors returned by constructors that load a model (e.g.,
+// NewPunktSentenceTokenizer) or compile a user-supplied pattern (e.g.,
+// NewRegexpTokenizer). Use IsKind (or, with Go 1.13 or later, errors.Is) to
+// check for them.
+var (
+	ErrModelCorrupt = util.ErrModelCorrupt
+	ErrBadPattern   = util.ErrBadPattern
+)
+
+// IsKind determines if err is of the given kind (e.g., ErrBadPattern).
+func IsKind(err, kind error) bool {
+	return util.IsKind(err, kind)
+}
+
 // ProseTokenizer is the interface implemented by an object that takes a string
 // and returns a slice of substrings.
 type ProseTokenizer interface {
 	Tokenize(text string) []string
 }
 
+// A Span is a token along with its location in the text it was taken from.
+//
+// Text is the token exactly as Tokenize would return it, which isn't always
+// identical to the source text (e.g., TreebankWordTokenizer converts double
+// quotes into Penn Treebank-style quotes); Start and End always refer to the
+// source text.
+type Span struct {
+	Text      string // the token
+	Start     int    // byte offset of the token's first character
+	End       int    // byte offset just past the token's last character
+	RuneStart int    // rune offset of the token's first character
+	RuneEnd   int    // rune offset just past the token's last character
+}
+
+// SpanTokenizer is the interface implemented by a ProseTokenizer that can also
+// report where each of its tokens occurs in the original text.
+type SpanTokenizer interface {
+	ProseTokenizer
+	TokenizeSpans(text string) []Span
+}
+
+// ParagraphSplitter is the interface implemented by a sentence tokenizer that
+// determines how its input is split into paragraphs (e.g., a
+// PragmaticSegmenter for PDF text).
+type ParagraphSplitter interface {
+	SplitParagraphs(text string) []string
+}
+
 // TextToWords converts the string text into a slice of words.
 //
 // It does so by tokenizing text into sentences (using a port of NLTK's punkt
 // tokenizer; see https://github.com/neurosnap/sentences) and then tokenizing
 // the sentences into words via TreebankWordTokenizer.
 func TextToWords(text string) []string {
-	sentTokenizer := NewPunktSentenceTokenizer()
-	wordTokenizer := NewTreebankWordTokenizer()
+	sentTokenizer, wordTokenizer, err := defaultTokenizers()
+	util.CheckError(err)
+	return textToWords(text, sentTokenizer, wordTokenizer)
+}
 
+// BatchTokenize is like TextToWords, but it tokenizes many texts at once using
+// up to workers goroutines (or one per CPU if workers < 1). The words of
+// texts[i] are at index i of the returned slice.
+//
+// If ctx is canceled before every text has been tokenized, BatchTokenize stops
+// early and returns ctx.Err().
+func BatchTokenize(ctx context.Context, texts []string, workers int) ([][]string, error) {
+	sentTokenizer, wordTokenizer, err := defaultTokenizers()
+	if err != nil {
+		return nil, err
+	}
+
+	words := make([][]string, len(texts))
+	err = util.ParallelFor(ctx, len(texts), workers, func(i int) {
+		words[i] = textToWords(texts[i], sentTokenizer, wordTokenizer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
+
+func textToWords(text string, sentTokenizer, wordTokenizer ProseTokenizer) []string {
 	words := []string{}
 	for _, s := range sentTokenizer.Tokenize(text) {
 		words = append(words, wordTokenizer.Tokenize(s)...)
 	}
+	return words
+}
+
+// The tokenizers used by TextToWords, TextToWordSpans, and BatchTokenize are
+// safe for concurrent use, so they're only loaded once.
+var defaults struct {
+	once sync.Once
+	sent *PunktSentenceTokenizer
+	word *TreebankWordTokenizer
+	err  error
+}
+
+func defaultTokenizers() (*PunktSentenceTokenizer, *TreebankWordTokenizer, error) {
+	defaults.once.Do(func() {
+		defaults.sent, defaults.err = NewPunktSentenceTokenizer()
+		defaults.word = NewTreebankWordTokenizer()
+	})
+	return defaults.sent, defaults.word, defaults.err
+}
+
+// TextToWordSpans is like TextToWords, but it returns Spans whose offsets are
+// relative to text (rather than to the sentence each word was found in).
+func TextToWordSpans(text string) []Span {
+	sentTokenizer, wordTokenizer, err := defaultTokenizers()
+	util.CheckError(err)
+
+	words := []Span{}
+	for _, s := range sentTokenizer.TokenizeSpans(text) {
+		for _, w := range wordTokenizer.TokenizeSpans(s.Text) {
+			w.Start += s.Start
+			w.End += s.Start
+			w.RuneStart += s.RuneStart
+			w.RuneEnd += s.RuneStart
+			words = append(words, w)
+		}
+	}
 
 	return words
 }
+
+// alignSpans locates each of tokens, in order, within text.
+//
+// This is used by tokenizers that don't track offsets themselves. Tokens are
+// allowed to differ from the source text in their whitespace, in their
+// representation of double quotes, and in words that were hyphenated across
+// a line break; a token that can't be found is given an empty Span at the
+// current position.
+func alignSpans(text string, tokens []string) []Span {
+	spans := make([]Span, 0, len(tokens))
+	cursor := 0
+	for _, tok := range tokens {
+		start, end := locate(text, tok, cursor)
+		spans = append(spans, Span{Text: tok, Start: start, End: end})
+		cursor = end
+	}
+	return withRuneOffsets(text, spans)
+}
+
+// locate finds the first occurrence of tok in text at or after from.
+func locate(text, tok string, from int) (int, int) {
+	start := from
+	if !startsWithSpace(tok) {
+		start = skipSpace(text, from)
+	}
+
+	if end, ok := matchAt(text, tok, start); ok {
+		return start, end
+	} else if idx := strings.Index(text[from:], tok); idx >= 0 {
+		return from + idx, from + idx + len(tok)
+	}
+
+	for i := start + 1; i < len(text); i++ {
+		if !utf8.RuneStart(text[i]) {
+			continue
+		} else if end, ok := matchAt(text, tok, i); ok {
+			return i, end
+		}
+	}
+
+	return from, from
+}
+
+// matchAt determines if tok occurs in text at the byte offset pos, returning
+// the offset at which the match ends.
+func matchAt(text, tok string, pos int) (int, bool) {
+	i, j := 0, pos
+	for i < len(tok) {
+		if isSpace(tok, i) {
+			i = skipSpace(tok, i)
+			j = skipSpace(text, j)
+			continue
+		} else if j >= len(text) {
+			return 0, false
+		}
+
+		if text[j] == '"' {
+			if strings.HasPrefix(tok[i:], "``") || strings.HasPrefix(tok[i:], "''") {
+				i += 2
+				j++
+				continue
+			}
+		}
+
+		if text[j] == '-' && tok[i] != '-' {
+			if k := skipSpace(text, j+1); strings.Contains(text[j+1:k], "\n") {
+				j = k
+				continue
+			}
+		}
+
+		if tok[i] != text[j] {
+			return 0, false
+		}
+		i++
+		j++
+	}
+	return j, true
+}
+
+// withRuneOffsets fills in the rune offsets of spans, which must be sorted by
+// their byte offsets.
+func withRuneOffsets(text string, spans []Span) []Span {
+	pos, count := 0, 0
+	for i := range spans {
+		count += utf8.RuneCountInString(text[pos:spans[i].Start])
+		spans[i].RuneStart = count
+		spans[i].RuneEnd = count + utf8.RuneCountInString(
+			text[spans[i].Start:spans[i].End])
+		pos = spans[i].Start
+	}
+	return spans
+}
+
+func startsWithSpace(s string) bool {
+	return s != "" && isSpace(s, 0)
+}
+
+func isSpace(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsSpace(r)
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
@@ -1,10 +1,13 @@
 package tokenize
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/jdkato/prose/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -68,3 +71,94 @@ func TestTextToWords(t *testing.T) {
 		"."}
 	assert.Equal(t, expected, TextToWords(text))
 }
+
+func ExampleTextToWordSpans() {
+	for _, w := range TextToWordSpans("Hi there. I'm here.")[3:6] {
+		fmt.Println(w.Text, w.Start, w.End)
+	}
+	// Output:
+	// I 10 11
+	// 'm 11 13
+	// here 14 18
+}
+
+var spanQuotes = strings.NewReplacer("``", `"`, "''", `"`)
+
+func checkSpans(t *testing.T, tok SpanTokenizer, text string) {
+	tokens := tok.Tokenize(text)
+	spans := tok.TokenizeSpans(text)
+	if !assert.Equal(t, len(tokens), len(spans), text) {
+		return
+	}
+	for i, s := range spans {
+		assert.Equal(t, tokens[i], s.Text)
+		assert.Equal(t, utf8.RuneCountInString(text[:s.Start]), s.RuneStart)
+		assert.Equal(t, utf8.RuneCountInString(text[:s.End]), s.RuneEnd)
+		assert.Equal(t,
+			strings.Join(strings.Fields(spanQuotes.Replace(s.Text)), ""),
+			strings.Join(strings.Fields(text[s.Start:s.End]), ""))
+	}
+}
+
+func TestTokenizeSpans(t *testing.T) {
+	var input []string
+	cases := util.ReadDataFile(filepath.Join(testdata, "tokenize.json"))
+	util.CheckError(json.Unmarshal(cases, &input))
+
+	pragmatic, err := NewPragmaticSegmenter("en")
+	util.CheckError(err)
+
+	tokenizers := []SpanTokenizer{
+		NewTreebankWordTokenizer(), MustNewPunktSentenceTokenizer(), pragmatic,
+		NewWordPunctTokenizer(), NewWordBoundaryTokenizer(),
+		NewBlanklineTokenizer(), MustNewRegexpTokenizer(`\s+`, true, false),
+		NewTweetTokenizer(), NewUnicodeWordTokenizer(),
+	}
+	for _, tok := range tokenizers {
+		for _, s := range append(input, getWordBenchData()...) {
+			checkSpans(t, tok, s)
+		}
+		checkSpans(t, tok, "“Ünïcödé” text—with \"quotes\".\n\nAnd a paragraph.")
+	}
+}
+
+func TestTextToWordSpans(t *testing.T) {
+	text := "Vale is a natural language linter. Vale doesn't attempt to offer a one-size-fits-all collection of rules—instead, it strives."
+	words := TextToWords(text)
+	spans := TextToWordSpans(text)
+	if assert.Equal(t, len(words), len(spans)) {
+		for i, s := range spans {
+			assert.Equal(t, words[i], s.Text)
+			assert.Equal(t, s.Text, text[s.Start:s.End])
+			assert.Equal(t, s.Text, string([]rune(text)[s.RuneStart:s.RuneEnd]))
+		}
+	}
+}
+
+func TestBatchTokenize(t *testing.T) {
+	text := string(util.ReadDataFile(filepath.Join(testdata, "sherlock.txt")))
+	texts := NewBlanklineTokenizer().Tokenize(text)
+
+	words, err := BatchTokenize(context.Background(), texts, 4)
+	util.CheckError(err)
+	if assert.Equal(t, len(texts), len(words)) {
+		for i, s := range texts {
+			assert.Equal(t, TextToWords(s), words[i])
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	words, err = BatchTokenize(ctx, texts, 4)
+	assert.Nil(t, words)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func BenchmarkBatchTokenize(b *testing.B) {
+	text := string(util.ReadDataFile(filepath.Join(testdata, "sherlock.txt")))
+	texts := NewBlanklineTokenizer().Tokenize(text)
+	for n := 0; n < b.N; n++ {
+		_, err := BatchTokenize(context.Background(), texts, 0)
+		util.CheckError(err)
+	}
+}
@@ -17,35 +17,32 @@ func NewTreebankWordTokenizer() *TreebankWordTokenizer {
 	return new(TreebankWordTokenizer)
 }
 
-var startingQuotes = map[string]*regexp.Regexp{
-	"$1 `` ": regexp.MustCompile(`'([ (\[{<])"`),
-	"``":     regexp.MustCompile(`^(")`),
-	" ``":    regexp.MustCompile(`( ")`),
+// The substitutions below are applied in order, mirroring the Sed script. (Go
+// maps have no defined iteration order, so the order of overlapping rules must
+// be explicit.)
+var startingQuotes = []rule{
+	{pattern: regexp.MustCompile(`^"`), replacement: "``"},
+	{pattern: regexp.MustCompile("(``)"), replacement: " $1 "},
+	{pattern: regexp.MustCompile(`([ (\[{<])"`), replacement: "$1 `` "},
 }
-var startingQuotes2 = map[string]*regexp.Regexp{
-	" $1 ": regexp.MustCompile("(``)"),
+var punctuation = []rule{
+	{pattern: regexp.MustCompile(`([:,])([^\d])`), replacement: " $1 $2"},
+	{pattern: regexp.MustCompile(`([:,])$`), replacement: " $1 "},
+	{pattern: regexp.MustCompile(`\.\.\.`), replacement: " ... "},
+	{pattern: regexp.MustCompile(`([;@#$%&])`), replacement: " $1 "},
+	{pattern: regexp.MustCompile(`([^\.])(\.)([\]\)}>"\']*)\s*$`), replacement: "$1 $2$3 "},
+	{pattern: regexp.MustCompile(`([?!])`), replacement: " $1 "},
+	{pattern: regexp.MustCompile(`([^'])' `), replacement: "$1 ' "},
 }
-var punctuation = map[string]*regexp.Regexp{
-	" $1 $2":   regexp.MustCompile(`([:,])([^\d])`),
-	" ... ":    regexp.MustCompile(`\.\.\.`),
-	"$1 $2$3 ": regexp.MustCompile(`([^\.])(\.)([\]\)}>"\']*)\s*$`),
-	"$1 ' ":    regexp.MustCompile(`([^'])' `),
+var brackets = []rule{
+	{pattern: regexp.MustCompile(`([\]\[\(\)\{\}\<\>])`), replacement: " $1 "},
+	{pattern: regexp.MustCompile(`--`), replacement: " -- "},
 }
-var punctuation2 = []*regexp.Regexp{
-	regexp.MustCompile(`([:,])$`),
-	regexp.MustCompile(`([;@#$%&?!])`),
-}
-var brackets = map[string]*regexp.Regexp{
-	" $1 ": regexp.MustCompile(`([\]\[\(\)\{\}\<\>])`),
-	" -- ": regexp.MustCompile(`--`),
-}
-var endingQuotes = map[string]*regexp.Regexp{
-	" '' ": regexp.MustCompile(`"`),
-}
-var endingQuotes2 = []*regexp.Regexp{
-	regexp.MustCompile(`'(\S)(\'\')'`),
-	regexp.MustCompile(`([^' ])('[sS]|'[mM]|'[dD]|') `),
-	regexp.MustCompile(`([^' ])('ll|'LL|'re|'RE|'ve|'VE|n't|N'T) `),
+var endingQuotes = []rule{
+	{pattern: regexp.MustCompile(`"`), replacement: " '' "},
+	{pattern: regexp.MustCompile(`(\S)('')`), replacement: "$1 $2 "},
+	{pattern: regexp.MustCompile(`([^' ])('[sS]|'[mM]|'[dD]|') `), replacement: "$1 $2 "},
+	{pattern: regexp.MustCompile(`([^' ])('ll|'LL|'re|'RE|'ve|'VE|n't|N'T) `), replacement: "$1 $2 "},
 }
 var contractions = []*regexp.Regexp{
 	regexp.MustCompile(`(?i)\b(can)(not)\b`),
@@ -72,41 +69,109 @@ var spaces = regexp.MustCompile(`(?: {2,})`)
 // NOTE: As mentioned above, this function expects a sentence (not raw text) as
 // input.
 func (t TreebankWordTokenizer) Tokenize(text string) []string {
-	for substitution, r := range startingQuotes {
-		text = r.ReplaceAllString(text, substitution)
-	}
+	text = applySubstitutions(text, startingQuotes)
+	text = applySubstitutions(text, punctuation)
+	text = applySubstitutions(text, brackets)
 
-	for substitution, r := range startingQuotes2 {
-		text = r.ReplaceAllString(text, substitution)
-	}
+	text = " " + text + " "
+	text = applySubstitutions(text, endingQuotes)
 
-	for substitution, r := range punctuation {
-		text = r.ReplaceAllString(text, substitution)
+	for _, r := range contractions {
+		text = r.ReplaceAllString(text, " $1 $2 ")
 	}
 
-	for _, r := range punctuation2 {
-		text = r.ReplaceAllString(text, " $1 ")
-	}
+	text = newlines.ReplaceAllString(text, " ")
+	text = strings.TrimSpace(spaces.ReplaceAllString(text, " "))
+	return strings.Split(text, " ")
+}
 
-	for substitution, r := range brackets {
-		text = r.ReplaceAllString(text, substitution)
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// word in text.
+func (t TreebankWordTokenizer) TokenizeSpans(text string) []Span {
+	return alignSpans(text, t.Tokenize(text))
+}
+
+// applySubstitutions replaces each match of a rule's pattern with its
+// replacement, which may refer to the pattern's capture groups (unlike
+// rule.sub).
+func applySubstitutions(text string, rules []rule) string {
+	for _, r := range rules {
+		text = r.pattern.ReplaceAllString(text, r.replacement)
 	}
+	return text
+}
 
-	text = " " + text + " "
+// TreebankWordDetokenizer reverses the TreebankWordTokenizer, converting a
+// slice of words back into a sentence.
+//
+// This implementation is a port of NLTK's TreebankWordDetokenizer.
+type TreebankWordDetokenizer struct {
+}
 
-	for substitution, r := range endingQuotes {
-		text = r.ReplaceAllString(text, substitution)
-	}
+// NewTreebankWordDetokenizer is a TreebankWordDetokenizer constructor.
+func NewTreebankWordDetokenizer() *TreebankWordDetokenizer {
+	return new(TreebankWordDetokenizer)
+}
 
-	for _, r := range endingQuotes2 {
-		text = r.ReplaceAllString(text, "$1 $2 ")
-	}
+// The TreebankWordTokenizer's substitutions, reversed. These are applied in
+// the opposite order of the tokenizer's: contractions, ending quotes,
+// brackets, punctuation, and then starting quotes.
+var detokenizerContractions = []rule{
+	{pattern: regexp.MustCompile(`(?i) ('t)\s(is)\b`), replacement: " $1$2"},
+	{pattern: regexp.MustCompile(`(?i) ('t)\s(was)\b`), replacement: " $1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(can)\s(not)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(d)\s('ye)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(gim)\s(me)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(gon)\s(na)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(got)\s(ta)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(lem)\s(me)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(mor)\s('n)\b`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`(?i)\b(wan)\s(na)(\s)`), replacement: "$1$2$3"},
+}
+var detokenizerEndingQuotes = []rule{
+	{pattern: regexp.MustCompile(`([^' ])\s('ll|'LL|'re|'RE|'ve|'VE|n't|N'T) `), replacement: "$1$2 "},
+	{pattern: regexp.MustCompile(`([^' ])\s('[sS]|'[mM]|'[dD]|') `), replacement: "$1$2 "},
+	{pattern: regexp.MustCompile(`(\S)\s('')`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`('')\s([.,:)\]>};%])`), replacement: "$1$2"},
+	{pattern: regexp.MustCompile(`''`), replacement: `"`},
+}
+var detokenizerBrackets = []rule{
+	{pattern: regexp.MustCompile(` -- `), replacement: "--"},
+	{pattern: regexp.MustCompile(`([\[\(\{\<])\s`), replacement: "$1"},
+	{pattern: regexp.MustCompile(`\s([\]\)\}\>])`), replacement: "$1"},
+	{pattern: regexp.MustCompile(`([\]\)\}\>])\s([:;,.])`), replacement: "$1$2"},
+}
+var detokenizerPunctuation = []rule{
+	{pattern: regexp.MustCompile(`([^'])\s'\s`), replacement: "$1' "},
+	{pattern: regexp.MustCompile(`\s([?!])`), replacement: "$1"},
+	{pattern: regexp.MustCompile(`([^\.])\s(\.)([\]\)}>"\']*)\s*$`), replacement: "$1$2$3"},
+	{pattern: regexp.MustCompile(`([#$])\s`), replacement: "$1"},
+	{pattern: regexp.MustCompile(`\s([;%])`), replacement: "$1"},
+	{pattern: regexp.MustCompile(`\s\.\.\.\s`), replacement: "..."},
+	{pattern: regexp.MustCompile(`\s([:,])`), replacement: "$1"},
+}
+var detokenizerStartingQuotes = []rule{
+	{pattern: regexp.MustCompile("([ (\\[{<])\\s``"), replacement: "$1``"},
+	{pattern: regexp.MustCompile("(``)\\s"), replacement: "$1"},
+	{pattern: regexp.MustCompile("``"), replacement: `"`},
+}
 
-	for _, r := range contractions {
-		text = r.ReplaceAllString(text, " $1 $2 ")
-	}
+// Detokenize joins a slice of words, as returned by TreebankWordTokenizer,
+// into a sentence.
+//
+// The spacing around punctuation, contractions (e.g., [do n't] -> "don't"),
+// and Penn Treebank-style quotes are all converted back into their usual
+// forms.
+func (d TreebankWordDetokenizer) Detokenize(words []string) string {
+	text := " " + strings.Join(words, " ") + " "
 
-	text = newlines.ReplaceAllString(text, " ")
-	text = strings.TrimSpace(spaces.ReplaceAllString(text, " "))
-	return strings.Split(text, " ")
+	text = applySubstitutions(text, detokenizerContractions)
+	text = applySubstitutions(text, detokenizerEndingQuotes)
+	text = strings.TrimSpace(text)
+
+	text = applySubstitutions(text, detokenizerBrackets)
+	text = applySubstitutions(text, detokenizerPunctuation)
+	text = applySubstitutions(text, detokenizerStartingQuotes)
+
+	return strings.TrimSpace(text)
 }
@@ -1,6 +1,8 @@
 package tokenize
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +16,73 @@ func TestTreebankWordTokenizer(t *testing.T) {
 	}
 }
 
+func TestTreebankWordTokenizerDeterministic(t *testing.T) {
+	input, _ := getWordData("treebank_words.json")
+	word := NewTreebankWordTokenizer()
+	for _, s := range input {
+		expected := word.Tokenize(s)
+		for i := 0; i < 100; i++ {
+			if !assert.Equal(t, expected, word.Tokenize(s)) {
+				break
+			}
+		}
+	}
+}
+
+func ExampleTreebankWordDetokenizer() {
+	d := NewTreebankWordDetokenizer()
+	fmt.Println(d.Detokenize([]string{
+		"``", "They", "'ll", "save", "and", "invest", "more", ",", "''",
+		"he", "said", "."}))
+	// Output: "They'll save and invest more," he said.
+}
+
+func TestTreebankWordDetokenizer(t *testing.T) {
+	input, output := getWordData("treebank_words.json")
+	word := NewTreebankWordTokenizer()
+	detok := NewTreebankWordDetokenizer()
+	for _, words := range output {
+		assert.Equal(t, words, word.Tokenize(detok.Detokenize(words)))
+	}
+
+	// Like NLTK's, the detokenizer can't restore everything: it closes up
+	// ellipses and tokenized URLs and emails, removes the space before a final
+	// period, and joins lines.
+	exceptions := map[int]string{
+		10:  "At eight o'clock on Thursday morning...Arthur didn't feel very good.",
+		35:  "Her email is Jane.Doe @ example.com.",
+		37:  "The site is: https: //www.example.50.com/new-site/awesome_content.html.",
+		79:  "This is a sentence cut off in the middle because pdf.",
+		80:  "It was a cold night in the city.",
+		81:  "features contact manager events, activities",
+		94:  strings.TrimSuffix(input[94], " .") + ".",
+		100: "I wasn’t really...well, what I mean...see.",
+		103: "what I'm saying, the thing is.",
+		107: "One further habit which was somewhat weakened.",
+	}
+	for i, s := range input {
+		expected, found := exceptions[i]
+		if !found {
+			expected = s
+		}
+		assert.Equal(t, expected, detok.Detokenize(word.Tokenize(s)))
+	}
+
+	for _, s := range []string{
+		"The quick brown fox jumps over the lazy dog.",
+		"The plane, bound for St Petersburg, crashed in Egypt's Sinai desert just 23 minutes after take-off from Sharm el-Sheikh on Saturday.",
+		`"We beat some pretty good teams to get here," Slocum said.`,
+		`Well, we couldn't have this predictable, cliche-ridden, "Touched by an Angel" (a show creator John Masius worked on) wanna-be if she didn't.`,
+		"I cannot cannot work under these conditions!",
+		"The company spent $30,000,000 to buy it.",
+		"They'll save and invest more--or won't they?",
+		"Is this [really] {all} there is?",
+		"Hello: it's 5 o'clock; let's go!",
+	} {
+		assert.Equal(t, s, detok.Detokenize(word.Tokenize(s)))
+	}
+}
+
 func BenchmarkTreebankWordTokenizer(b *testing.B) {
 	word := NewTreebankWordTokenizer()
 	for n := 0; n < b.N; n++ {
@@ -0,0 +1,96 @@
+package tokenize
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// TweetTokenizer splits social-media text (e.g., tweets, chat messages, or
+// support tickets) into words.
+//
+// Unlike TreebankWordTokenizer, it keeps URLs, email addresses, Twitter-style
+// handles and hashtags, emoticons (e.g., ":-)"), and emoji sequences (e.g.,
+// skin-tone modifiers and ZWJ sequences such as "👩‍💻") together as single
+// tokens.
+//
+// This implementation is a port of NLTK's TweetTokenizer.
+type TweetTokenizer struct {
+	StripHandles bool // discard handles (e.g., "@jdkato")
+	ReduceLen    bool // shorten elongated words (e.g., "soooo" -> "sooo")
+}
+
+// NewTweetTokenizer is a TweetTokenizer constructor.
+func NewTweetTokenizer() *TweetTokenizer {
+	return new(TweetTokenizer)
+}
+
+var tweetURLs = `(?i:https?://|www\d{0,3}[.])` +
+	`(?:[^\s()<>{}\[\]]+|\([^\s()]*?\([^\s()]+\)[^\s()]*?\)|\([^\s]+?\))+` +
+	`(?:\([^\s()]*?\([^\s()]+\)[^\s()]*?\)|\([^\s]+?\)|` +
+	"[^\\s`!()\\[\\]{};:'\".,<>?«»“”‘’])"
+var tweetPhoneNumbers = `(?:(?:\+?[01][ *\-.\)]*)?(?:[\(]?\d{3}[ *\-.\)]*)?` +
+	`\d{3}[ *\-.\)]*\d{4})`
+var tweetEmoticons = `(?:[<>]?[:;=8][\-o\*\']?[\)\]\(\[dDpP/\:\}\{@\|\\]|` +
+	`[\)\]\(\[dDpP/\:\}\{@\|\\][\-o\*\']?[:;=8][<>]?|</?3)`
+var tweetEmoji = `(?:[\x{1F1E6}-\x{1F1FF}]{2}|[0-9#*]\x{FE0F}?\x{20E3}|` +
+	`\p{So}[\x{FE0F}\x{1F3FB}-\x{1F3FF}]*` +
+	`(?:\x{200D}\p{So}[\x{FE0F}\x{1F3FB}-\x{1F3FF}]*)*)`
+var tweetHandles = `(?:@[\w_]+)`
+var tweetHashtags = `(?:\#+[\w_]+[\w\'_\-]*[\w_]+)`
+var tweetEmails = `(?:[\w.+-]+@[\w-]+\.(?:[\w-]\.?)+[\w-])`
+var tweetWords = `(?:[^\W\d_](?:[^\W\d_]|['\-_])+[^\W\d_])|` +
+	`(?:[+\-]?\d+[,/.:-]\d+[+\-]?)|(?:[\w_]+)|(?:\.(?:\s*\.){1,})|(?:\S)`
+
+var tweetRE = regexp.MustCompile(strings.Join([]string{
+	tweetURLs, tweetPhoneNumbers, tweetEmoticons, `<[^>\s]+>`,
+	`[\-]+>|<[\-]+`, tweetHandles, tweetHashtags, tweetEmails, tweetEmoji,
+	tweetWords}, "|"))
+var tweetHandleRE = regexp.MustCompile(`^` + tweetHandles + `$`)
+
+// Tokenize splits text into a slice of words.
+func (t TweetTokenizer) Tokenize(text string) []string {
+	tokens := []string{}
+	for _, span := range t.TokenizeSpans(text) {
+		tokens = append(tokens, span.Text)
+	}
+	return tokens
+}
+
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// word in text.
+//
+// If ReduceLen is true, a Span's Text may be shorter than the text between its
+// Start and End.
+func (t TweetTokenizer) TokenizeSpans(text string) []Span {
+	spans := []Span{}
+	for _, loc := range tweetRE.FindAllStringIndex(text, -1) {
+		token := text[loc[0]:loc[1]]
+		if t.StripHandles && tweetHandleRE.MatchString(token) {
+			continue
+		} else if t.ReduceLen {
+			token = reduceLengthening(token)
+		}
+		spans = append(spans, Span{Text: token, Start: loc[0], End: loc[1]})
+	}
+	return withRuneOffsets(text, spans)
+}
+
+// reduceLengthening replaces any sequence of more than three repeated
+// characters with just three of them (e.g., "waaaaayyyy" -> "waaayyy").
+func reduceLengthening(token string) string {
+	var buf bytes.Buffer
+	var last rune
+	count := 0
+	for _, r := range token {
+		if r == last {
+			count++
+		} else {
+			last, count = r, 1
+		}
+		if count <= 3 {
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
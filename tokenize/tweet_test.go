@@ -0,0 +1,63 @@
+package tokenize
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleNewTweetTokenizer() {
+	t := NewTweetTokenizer()
+	fmt.Println(t.Tokenize("Thanks @jdkato! I <3 #golang :-)"))
+	// Output: [Thanks @jdkato ! I <3 #golang :-)]
+}
+
+func TestTweetTokenizer(t *testing.T) {
+	tests := []struct {
+		text   string
+		tokens []string
+	}{
+		{"This is a cooool #dummysmiley: :-) :-P <3 and some arrows < > -> <--",
+			[]string{"This", "is", "a", "cooool", "#dummysmiley", ":", ":-)",
+				":-P", "<3", "and", "some", "arrows", "<", ">", "->", "<--"}},
+		{"See https://x.y/z?a=1 and www.example.com/path, or email jane.doe@example.com.",
+			[]string{"See", "https://x.y/z?a=1", "and", "www.example.com/path",
+				",", "or", "email", "jane.doe@example.com", "."}},
+		{"I love #golang 👩‍💻 👍🏽 🇺🇸 ❤️ 1️⃣",
+			[]string{"I", "love", "#golang", "👩‍💻", "👍🏽", "🇺🇸", "❤️", "1️⃣"}},
+		{"Call me at (555) 123-4567... or not :(",
+			[]string{"Call", "me", "at", "(555) 123-4567", "...", "or", "not", ":("}},
+		{"It's a well-known fact, isn't it?",
+			[]string{"It's", "a", "well-known", "fact", ",", "isn't", "it", "?"}},
+	}
+
+	tok := NewTweetTokenizer()
+	for _, test := range tests {
+		assert.Equal(t, test.tokens, tok.Tokenize(test.text))
+	}
+}
+
+func TestTweetTokenizerOptions(t *testing.T) {
+	text := "@remy: This is waaaaayyyy too much for you!!!!!! @jdkato"
+
+	tok := NewTweetTokenizer()
+	tok.StripHandles = true
+	tok.ReduceLen = true
+	assert.Equal(t, []string{
+		":", "This", "is", "waaayyy", "too", "much", "for", "you", "!", "!",
+		"!", "!", "!", "!"}, tok.Tokenize(text))
+
+	spans := tok.TokenizeSpans(text)
+	assert.Equal(t, "waaaaayyyy", text[spans[3].Start:spans[3].End])
+	assert.Equal(t, "waaayyy", spans[3].Text)
+}
+
+func BenchmarkTweetTokenizer(b *testing.B) {
+	tok := NewTweetTokenizer()
+	for n := 0; n < b.N; n++ {
+		for _, s := range getWordBenchData() {
+			tok.Tokenize(s)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package tokenize
+
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnicodeWordTokenizer splits text into words according to the word-boundary
+// rules of Unicode Standard Annex #29 (https://www.unicode.org/reports/tr29/).
+//
+// Unlike NewWordBoundaryTokenizer, it keeps combining marks with the
+// characters they modify and treats contractions (e.g., "can't" or "can’t"),
+// numbers with separators (e.g., "1,000.50"), and emoji sequences as single
+// words. Segments without a letter or number (i.e., whitespace, punctuation,
+// and emoji) are discarded.
+//
+// Some scripts (e.g., Thai, Lao, Khmer, and Myanmar) don't use spaces between
+// words and require a dictionary to segment; consecutive characters of these
+// scripts are returned as a single token.
+type UnicodeWordTokenizer struct {
+}
+
+// NewUnicodeWordTokenizer is a UnicodeWordTokenizer constructor.
+func NewUnicodeWordTokenizer() *UnicodeWordTokenizer {
+	return new(UnicodeWordTokenizer)
+}
+
+// Tokenize splits text into a slice of words.
+func (t UnicodeWordTokenizer) Tokenize(text string) []string {
+	words := []string{}
+	for _, span := range t.TokenizeSpans(text) {
+		words = append(words, span.Text)
+	}
+	return words
+}
+
+// TokenizeSpans is like Tokenize, but it also returns the location of each
+// word in text.
+func (t UnicodeWordTokenizer) TokenizeSpans(text string) []Span {
+	spans := []Span{}
+	bounds := wordBoundaries(text)
+	for i := 0; i < len(bounds)-1; i++ {
+		start, end := bounds[i], bounds[i+1]
+		if !isWord(text[start:end]) {
+			continue
+		}
+		for isComplexContext(text[start:end]) && i+2 < len(bounds) &&
+			isComplexContext(text[end:bounds[i+2]]) {
+			i++
+			end = bounds[i+1]
+		}
+		spans = append(spans, Span{Text: text[start:end], Start: start, End: end})
+	}
+	return withRuneOffsets(text, spans)
+}
+
+// isWord determines if s contains a letter or a number.
+func isWord(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// isComplexContext determines if s starts with a character from a script that
+// doesn't separate its words with spaces.
+func isComplexContext(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.In(r, unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar)
+}
+
+// A wordBreakProperty is a value of the Unicode Word_Break property (plus
+// Extended_Pictographic, which is also used by the word-boundary rules).
+type wordBreakProperty uint8
+
+const (
+	wbOther wordBreakProperty = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbZWJ
+	wbRegionalIndicator
+	wbFormat
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+	wbExtendedPictographic
+)
+
+// A wordBreakRange assigns a property to the code points lo through hi.
+type wordBreakRange struct {
+	lo, hi   rune
+	property wordBreakProperty
+}
+
+// lookupWordBreak finds the property of r in table, which must be sorted.
+func lookupWordBreak(r rune, table []wordBreakRange) wordBreakProperty {
+	i := sort.Search(len(table), func(i int) bool { return table[i].hi >= r })
+	if i < len(table) && table[i].lo <= r {
+		return table[i].property
+	}
+	return wbOther
+}
+
+// wordBoundaries returns the byte offsets of the word boundaries in text,
+// including 0 and len(text).
+//
+// The numbered comments refer to the rules of UAX #29 (Unicode 15.0.0).
+func wordBoundaries(text string) []int {
+	if text == "" {
+		return []int{0}
+	}
+
+	offsets := []int{}
+	props := []wordBreakProperty{}
+	pictographic := []bool{}
+	for i, r := range text {
+		offsets = append(offsets, i)
+		props = append(props, lookupWordBreak(r, wordBreakProperties))
+		pictographic = append(pictographic, lookupWordBreak(
+			r, extendedPictographic) == wbExtendedPictographic)
+	}
+
+	ignored := func(i int) bool {
+		p := props[i]
+		return p == wbExtend || p == wbFormat || p == wbZWJ
+	}
+	newline := func(i int) bool {
+		p := props[i]
+		return p == wbCR || p == wbLF || p == wbNewline
+	}
+	// before returns the index of the first character before i that isn't
+	// ignored by WB4 (or -1).
+	before := func(i int) int {
+		for i--; i > 0 && ignored(i) && !newline(i-1); i-- {
+		}
+		return i
+	}
+	// after returns the index of the first character after i that isn't
+	// ignored by WB4 (or len(props)).
+	after := func(i int) int {
+		for i++; i < len(props) && ignored(i); i++ {
+		}
+		return i
+	}
+	prop := func(i int) wordBreakProperty {
+		if i < 0 || i >= len(props) {
+			return wbOther
+		}
+		return props[i]
+	}
+	ahLetter := func(p wordBreakProperty) bool {
+		return p == wbALetter || p == wbHebrewLetter
+	}
+	midNumLetQ := func(p wordBreakProperty) bool {
+		return p == wbMidNumLet || p == wbSingleQuote
+	}
+
+	bounds := []int{0}
+	for i := 1; i < len(props); i++ {
+		prev, next := props[i-1], props[i]
+		switch {
+		case prev == wbCR && next == wbLF: // WB3
+			continue
+		case newline(i - 1), newline(i): // WB3a, WB3b
+			bounds = append(bounds, offsets[i])
+			continue
+		case prev == wbZWJ && pictographic[i]: // WB3c
+			continue
+		case prev == wbWSegSpace && next == wbWSegSpace: // WB3d
+			continue
+		case ignored(i): // WB4
+			continue
+		}
+
+		// WB4: skip over any Extend, Format, and ZWJ characters.
+		p := before(i)
+		pp, nn := prop(before(p)), prop(after(i))
+		prev = props[p]
+
+		switch {
+		case ahLetter(prev) && ahLetter(next): // WB5
+		case ahLetter(prev) && (next == wbMidLetter || midNumLetQ(next)) &&
+			ahLetter(nn): // WB6
+		case ahLetter(pp) && (prev == wbMidLetter || midNumLetQ(prev)) &&
+			ahLetter(next): // WB7
+		case prev == wbHebrewLetter && next == wbSingleQuote: // WB7a
+		case prev == wbHebrewLetter && next == wbDoubleQuote &&
+			nn == wbHebrewLetter: // WB7b
+		case pp == wbHebrewLetter && prev == wbDoubleQuote &&
+			next == wbHebrewLetter: // WB7c
+		case prev == wbNumeric && next == wbNumeric: // WB8
+		case ahLetter(prev) && next == wbNumeric: // WB9
+		case prev == wbNumeric && ahLetter(next): // WB10
+		case pp == wbNumeric && (prev == wbMidNum || midNumLetQ(prev)) &&
+			next == wbNumeric: // WB11
+		case prev == wbNumeric && (next == wbMidNum || midNumLetQ(next)) &&
+			nn == wbNumeric: // WB12
+		case prev == wbKatakana && next == wbKatakana: // WB13
+		case (ahLetter(prev) || prev == wbNumeric || prev == wbKatakana ||
+			prev == wbExtendNumLet) && next == wbExtendNumLet: // WB13a
+		case prev == wbExtendNumLet && (ahLetter(next) ||
+			next == wbNumeric || next == wbKatakana): // WB13b
+		case prev == wbRegionalIndicator && next == wbRegionalIndicator:
+			// WB15, WB16: only pairs of regional indicators are joined.
+			count := 0
+			for j := p; j >= 0 && props[j] == wbRegionalIndicator; j = before(j) {
+				count++
+			}
+			if count%2 == 0 {
+				bounds = append(bounds, offsets[i])
+			}
+		default: // WB999
+			bounds = append(bounds, offsets[i])
+		}
+	}
+
+	return append(bounds, len(text))
+}
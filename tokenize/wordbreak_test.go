@@ -0,0 +1,79 @@
+package tokenize
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jdkato/prose/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleNewUnicodeWordTokenizer() {
+	t := NewUnicodeWordTokenizer()
+	fmt.Println(t.Tokenize("They can’t pay $1,000.50 for a naïve café."))
+	// Output: [They can’t pay 1,000.50 for a naïve café]
+}
+
+func TestWordBreakTest(t *testing.T) {
+	data := util.ReadDataFile(filepath.Join(testdata, "WordBreakTest.txt"))
+	for _, line := range strings.Split(string(data), "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		text, expected := "", []int{}
+		for _, field := range strings.Fields(line) {
+			switch field {
+			case "÷":
+				expected = append(expected, len(text))
+			case "×":
+			default:
+				r, err := strconv.ParseUint(field, 16, 32)
+				util.CheckError(err)
+				text += string(rune(r))
+			}
+		}
+		assert.Equal(t, expected, wordBoundaries(text), line)
+	}
+}
+
+func TestUnicodeWordTokenizer(t *testing.T) {
+	tests := []struct {
+		text  string
+		words []string
+	}{
+		{"Vale doesn't attempt to offer a one-size-fits-all collection.",
+			[]string{"Vale", "doesn't", "attempt", "to", "offer", "a", "one",
+				"size", "fits", "all", "collection"}},
+		{"It costs 1,000.50 (or 3.5%) in the U.S.A.",
+			[]string{"It", "costs", "1,000.50", "or", "3.5", "in", "the", "U.S.A"}},
+		{"Le garçon a mangé l’orange. Ça va?",
+			[]string{"Le", "garçon", "a", "mangé", "l’orange", "Ça", "va"}},
+		{"été 👍🏽 snake_case",
+			[]string{"été", "snake_case"}},
+		{"ภาษาไทย ง่ายนิดเดียว",
+			[]string{"ภาษาไทย", "ง่ายนิดเดียว"}},
+		{"日本語のテキスト",
+			[]string{"日", "本", "語", "の", "テキスト"}},
+	}
+
+	tok := NewUnicodeWordTokenizer()
+	for _, test := range tests {
+		assert.Equal(t, test.words, tok.Tokenize(test.text))
+	}
+}
+
+func BenchmarkUnicodeWordTokenizer(b *testing.B) {
+	tok := NewUnicodeWordTokenizer()
+	for n := 0; n < b.N; n++ {
+		for _, s := range getWordBenchData() {
+			tok.Tokenize(s)
+		}
+	}
+}